@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+)
+
+func runAdmin(ctx context.Context, s *session, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: dfs admin user|group|account|key|node|audit <command> [args]")
+	}
+
+	client := proto.NewAdminClient(s.conn)
+
+	switch args[0] {
+	case "user":
+		return runAdminUser(ctx, client, args[1], args[2:])
+	case "group":
+		return runAdminGroup(ctx, client, args[1], args[2:])
+	case "account":
+		return runAdminAccount(ctx, client, args[1], args[2:])
+	case "key":
+		return runAdminKey(ctx, client, args[1], args[2:])
+	case "node":
+		return runAdminNode(ctx, client, args[1], args[2:])
+	case "audit":
+		return runAdminAudit(ctx, client, args[1], args[2:])
+	default:
+		return fmt.Errorf("unknown admin command: %s", args[0])
+	}
+}
+
+// parsePassword parses the arguments of commands that take a user name and a
+// new password.
+func parsePassword(command string, args []string) (string, string, error) {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	passwordFlag := flags.String("password", os.Getenv("DFS_NEW_PASSWORD"), "Password of the user")
+
+	if len(args) == 0 {
+		return "", "", fmt.Errorf("usage: dfs admin user %s <user> -password <password>", command)
+	}
+
+	err := flags.Parse(args[1:])
+	if err != nil {
+		return "", "", err
+	}
+
+	if *passwordFlag == "" {
+		return "", "", fmt.Errorf("password is required")
+	}
+
+	return args[0], *passwordFlag, nil
+}
+
+func requireArgs(args []string, usage string, count int) error {
+	if len(args) != count {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	return nil
+}
+
+func runAdminUser(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "create":
+		user, password, err := parsePassword(command, args)
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateUser(ctx, &proto.CreateUserRequest{User: user, Password: password})
+		return err
+	case "delete":
+		err := requireArgs(args, "dfs admin user delete <user>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.DeleteUser(ctx, &proto.DeleteUserRequest{User: args[0]})
+		return err
+	case "list":
+		resp, err := client.ListUsers(ctx, &proto.ListUsersRequest{})
+		if err != nil {
+			return err
+		}
+		for _, user := range resp.GetUsers() {
+			kind := "user"
+			if user.GetServiceAccount() {
+				kind = "service-account"
+			}
+			fmt.Printf("%s\t%s\t%s\t%s\n", user.GetName(), kind, strings.Join(user.GetGroups(), ","), user.GetUmask())
+		}
+		return nil
+	case "passwd":
+		user, password, err := parsePassword(command, args)
+		if err != nil {
+			return err
+		}
+		_, err = client.ChangeUserPassword(ctx, &proto.ChangeUserPasswordRequest{User: user, Password: password})
+		return err
+	case "unlock":
+		err := requireArgs(args, "dfs admin user unlock <user>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.UnlockUser(ctx, &proto.UnlockUserRequest{User: args[0]})
+		return err
+	case "expire":
+		err := requireArgs(args, "dfs admin user expire <user>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.ExpireUserPassword(ctx, &proto.ExpireUserPasswordRequest{User: args[0]})
+		return err
+	case "umask":
+		if len(args) != 1 && len(args) != 2 {
+			return fmt.Errorf("usage: dfs admin user umask <user> [<umask>]")
+		}
+		umask := ""
+		if len(args) == 2 {
+			umask = args[1]
+		}
+		_, err := client.SetUserUmask(ctx, &proto.SetUserUmaskRequest{User: args[0], Umask: umask})
+		return err
+	default:
+		return fmt.Errorf("unknown user command: %s", command)
+	}
+}
+
+func runAdminGroup(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "create":
+		err := requireArgs(args, "dfs admin group create <group>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateGroup(ctx, &proto.CreateGroupRequest{Group: args[0]})
+		return err
+	case "delete":
+		err := requireArgs(args, "dfs admin group delete <group>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.DeleteGroup(ctx, &proto.DeleteGroupRequest{Group: args[0]})
+		return err
+	case "list":
+		resp, err := client.ListGroups(ctx, &proto.ListGroupsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, group := range resp.GetGroups() {
+			fmt.Printf("%s\t%s\n", group.GetName(), strings.Join(group.GetUsers(), ","))
+		}
+		return nil
+	case "add":
+		err := requireArgs(args, "dfs admin group add <group> <user>", 2)
+		if err != nil {
+			return err
+		}
+		_, err = client.AddUserToGroup(ctx, &proto.AddUserToGroupRequest{Group: args[0], User: args[1]})
+		return err
+	case "remove":
+		err := requireArgs(args, "dfs admin group remove <group> <user>", 2)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveUserFromGroup(ctx, &proto.RemoveUserFromGroupRequest{Group: args[0], User: args[1]})
+		return err
+	default:
+		return fmt.Errorf("unknown group command: %s", command)
+	}
+}
+
+func runAdminAccount(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "create":
+		err := requireArgs(args, "dfs admin account create <account>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateServiceAccount(ctx, &proto.CreateServiceAccountRequest{User: args[0]})
+		return err
+	default:
+		return fmt.Errorf("unknown account command: %s", command)
+	}
+}
+
+// formatUnix formats seconds since the epoch, 0 as never.
+func formatUnix(seconds int64) string {
+	if seconds == 0 {
+		return "never"
+	}
+
+	return time.Unix(seconds, 0).Format(time.RFC3339)
+}
+
+// parseKeyID parses the arguments of commands that take an account and the
+// id of one of its keys.
+func parseKeyID(command string, args []string) (string, uint64, error) {
+	err := requireArgs(args, fmt.Sprintf("dfs admin key %s <account> <id>", command), 2)
+	if err != nil {
+		return "", 0, err
+	}
+
+	id, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid key id %s: %w", args[1], err)
+	}
+
+	return args[0], id, nil
+}
+
+func runAdminKey(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "create":
+		flags := flag.NewFlagSet(command, flag.ContinueOnError)
+		expiresFlag := flags.Duration("expires", 0, "Lifetime of the key, it does not expire when 0")
+		if len(args) == 0 {
+			return fmt.Errorf("usage: dfs admin key create <account> [-expires <duration>]")
+		}
+		err := flags.Parse(args[1:])
+		if err != nil {
+			return err
+		}
+		var expiresAt int64
+		if *expiresFlag > 0 {
+			expiresAt = time.Now().Add(*expiresFlag).Unix()
+		}
+		resp, err := client.CreateAPIKey(ctx, &proto.CreateAPIKeyRequest{User: args[0], ExpiresAt: expiresAt})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d\t%s\n", resp.GetId(), resp.GetKey())
+		return nil
+	case "list":
+		err := requireArgs(args, "dfs admin key list <account>", 1)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListAPIKeys(ctx, &proto.ListAPIKeysRequest{User: args[0]})
+		if err != nil {
+			return err
+		}
+		for _, key := range resp.GetKeys() {
+			fmt.Printf("%d\t%s\t%s\t%s\n",
+				key.GetId(),
+				formatUnix(key.GetCreatedAt()),
+				formatUnix(key.GetExpiresAt()),
+				formatUnix(key.GetLastUsedAt()))
+		}
+		return nil
+	case "rotate":
+		account, id, err := parseKeyID(command, args)
+		if err != nil {
+			return err
+		}
+		resp, err := client.RotateAPIKey(ctx, &proto.RotateAPIKeyRequest{User: account, Id: id})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.GetKey())
+		return nil
+	case "revoke":
+		account, id, err := parseKeyID(command, args)
+		if err != nil {
+			return err
+		}
+		_, err = client.RevokeAPIKey(ctx, &proto.RevokeAPIKeyRequest{User: account, Id: id})
+		return err
+	default:
+		return fmt.Errorf("unknown key command: %s", command)
+	}
+}
+
+func runAdminNode(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "key":
+		err := requireArgs(args, "dfs admin node key <host>", 1)
+		if err != nil {
+			return err
+		}
+		resp, err := client.IssueNodeKey(ctx, &proto.IssueNodeKeyRequest{Host: args[0]})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.GetKey())
+		return nil
+	case "token":
+		err := requireArgs(args, "dfs admin node token <host>", 1)
+		if err != nil {
+			return err
+		}
+		resp, err := client.IssueNodeAccessToken(ctx, &proto.IssueNodeAccessTokenRequest{Host: args[0]})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.GetAccessToken())
+		return nil
+	default:
+		return fmt.Errorf("unknown node command: %s", command)
+	}
+}
+
+func runAdminAudit(ctx context.Context, client proto.AdminClient, command string, args []string) error {
+	switch command {
+	case "list":
+		flags := flag.NewFlagSet(command, flag.ContinueOnError)
+		userFlag := flags.String("user", "", "Only events of the user")
+		pathFlag := flags.String("path", "", "Only events at or below the path")
+		limitFlag := flags.Int("limit", 0, "Maximum number of events")
+		err := flags.Parse(args)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListAuditEvents(ctx, &proto.ListAuditEventsRequest{
+			User:  *userFlag,
+			Path:  *pathFlag,
+			Limit: int32(*limitFlag),
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range resp.GetEvents() {
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				time.Unix(event.GetTime(), 0).Format(time.RFC3339),
+				event.GetUser(),
+				event.GetProxy(),
+				event.GetClientIp(),
+				event.GetOperation(),
+				event.GetPath(),
+				event.GetResult())
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown audit command: %s", command)
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"google.golang.org/grpc"
+)
+
+const usage = `Usage: dfs [flags] <command> [args]
+
+Commands:
+  admin user create <user> -password <password>
+  admin user delete <user>
+  admin user list
+  admin user passwd <user> -password <password>
+  admin user umask <user> [<umask>]
+  admin user unlock <user>
+  admin user expire <user>
+  admin group create <group>
+  admin group delete <group>
+  admin group list
+  admin group add <group> <user>
+  admin group remove <group> <user>
+  admin account create <account>
+  admin key create <account> [-expires <duration>]
+  admin key list <account>
+  admin key rotate <account> <id>
+  admin key revoke <account> <id>
+  admin node key <host>
+  admin node token <host>
+  admin audit list [-user <user>] [-path <path>] [-limit <n>]
+  session list
+  session revoke <id>
+  session revoke-all
+  token create [-path <path>]... [-ops read,write,delete,execute] [-lifetime <duration>] [-renewer <user>]
+  token renew <token>
+  count [-q] <path>...
+  quota set [-namespace <n>] [-space <size>] <path>
+  quota clear <path>
+  quota user [<user> [<size>]]
+
+Flags:
+`
+
+// session is a logged in connection to the name node.
+type session struct {
+	conn  *grpc.ClientConn
+	token string
+	// delegated sessions use a given token, which is not logged out.
+	delegated bool
+}
+
+func main() {
+	nameNodeFlag := flag.String("name-node", "localhost:53035", "Name Node Address")
+	userFlag := flag.String("user", os.Getenv("USER"), "User Name")
+	passwordFlag := flag.String("password", os.Getenv("DFS_PASSWORD"), "Password")
+	newPasswordFlag := flag.String("new-password", "", "New password to set when logging in, required after the password expired")
+	tokenFlag := flag.String("token", os.Getenv("DFS_TOKEN"), "Token to use instead of logging in, such as a delegation token or an api key")
+	doAsFlag := flag.String("do-as", "", "User to act for, the user must be a proxy user")
+	timeoutFlag := flag.Duration("timeout", 1*time.Minute, "Request Timeout")
+	tlsFlag := flag.Bool("tls", false, "Connect to the name node with TLS")
+	tlsCAFlag := flag.String("tls-ca", "", "TLS CA File to verify the name node (system roots when empty)")
+	tlsCertFlag := flag.String("tls-cert", "", "TLS Client Certificate File")
+	tlsKeyFlag := flag.String("tls-key", "", "TLS Client Key File")
+
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	connectionFactory := proto.NewInsecureConnectionFactory()
+	if *tlsFlag || *tlsCAFlag != "" || *tlsCertFlag != "" {
+		certificates, err := proto.NewCertificates(proto.TLSOpts{
+			CertFile: *tlsCertFlag,
+			KeyFile:  *tlsKeyFlag,
+			CAFile:   *tlsCAFlag,
+		})
+		if err != nil {
+			fail(err)
+		}
+		connectionFactory = proto.NewTLSConnectionFactory(certificates)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeoutFlag)
+	defer cancel()
+
+	var s *session
+	var err error
+	if *tokenFlag != "" {
+		s, err = connect(connectionFactory, *nameNodeFlag, *tokenFlag)
+	} else {
+		s, err = login(ctx, connectionFactory, *nameNodeFlag, *userFlag, *passwordFlag, *newPasswordFlag)
+	}
+	if err != nil {
+		fail(err)
+	}
+	defer s.logout()
+
+	ctx = proto.WithBearerToken(ctx, s.token)
+	if *doAsFlag != "" {
+		ctx = proto.WithDoAs(ctx, *doAsFlag)
+	}
+
+	switch args[0] {
+	case "admin":
+		err = runAdmin(ctx, s, args[1:])
+	case "session":
+		err = runSession(ctx, s, args[1:])
+	case "token":
+		err = runToken(ctx, s, args[1:])
+	case "count":
+		err = runCount(ctx, s, args[1:])
+	case "quota":
+		err = runQuota(ctx, s, args[1:])
+	default:
+		err = fmt.Errorf("unknown command: %s", args[0])
+	}
+	if err != nil {
+		s.logout()
+		fail(err)
+	}
+}
+
+func login(ctx context.Context, connectionFactory proto.ConnectionFactory, nameNode string, user string, password string, newPassword string) (*session, error) {
+	conn, err := connectionFactory.CreateConnection(nameNode)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to name node %s: %w", nameNode, err)
+	}
+
+	resp, err := proto.NewNameClient(conn).Login(ctx, &proto.LoginRequest{
+		User:           user,
+		HashedPassword: password,
+		NewPassword:    newPassword,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not login as %s: %w", user, err)
+	}
+
+	return &session{conn: conn, token: resp.GetToken()}, nil
+}
+
+// connect returns a session using token, such as a delegation token.
+func connect(connectionFactory proto.ConnectionFactory, nameNode string, token string) (*session, error) {
+	conn, err := connectionFactory.CreateConnection(nameNode)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to name node %s: %w", nameNode, err)
+	}
+
+	return &session{conn: conn, token: token, delegated: true}, nil
+}
+
+func (s *session) logout() {
+	if s.conn == nil {
+		return
+	}
+
+	if s.delegated {
+		s.conn.Close()
+		s.conn = nil
+		return
+	}
+
+	ctx := proto.WithBearerToken(context.Background(), s.token)
+	_, _ = proto.NewNameClient(s.conn).Logout(ctx, &proto.LogoutRequest{Token: s.token})
+	s.conn.Close()
+	s.conn = nil
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "dfs:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+)
+
+// parseSize parses a number of bytes with an optional K, M, G or T suffix
+// for powers of 1024.
+func parseSize(value string) (uint64, error) {
+	multiplier := uint64(1)
+
+	trimmed := strings.ToUpper(strings.TrimSpace(value))
+	for i, suffix := range []string{"K", "M", "G", "T"} {
+		if strings.HasSuffix(trimmed, suffix) {
+			multiplier = 1 << (10 * (i + 1))
+			trimmed = strings.TrimSuffix(trimmed, suffix)
+			break
+		}
+	}
+
+	size, err := strconv.ParseUint(trimmed, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size: %s", value)
+	}
+
+	return size * multiplier, nil
+}
+
+// formatLimit prints a quota limit and what remains of it, or none and inf
+// when there is no limit.
+func formatLimit(limit uint64, used uint64) (string, string) {
+	if limit == 0 {
+		return "none", "inf"
+	}
+
+	return strconv.FormatUint(limit, 10), strconv.FormatInt(int64(limit)-int64(used), 10)
+}
+
+func runCount(ctx context.Context, s *session, args []string) error {
+	flags := flag.NewFlagSet("count", flag.ContinueOnError)
+	quotaFlag := flags.Bool("q", false, "Show the quotas and what remains of them")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("usage: dfs count [-q] <path>...")
+	}
+
+	client := proto.NewNameClient(s.conn)
+
+	if *quotaFlag {
+		fmt.Println("QUOTA\tREM_QUOTA\tSPACE_QUOTA\tREM_SPACE_QUOTA\tDIR_COUNT\tFILE_COUNT\tCONTENT_SIZE\tPATH")
+	} else {
+		fmt.Println("DIR_COUNT\tFILE_COUNT\tCONTENT_SIZE\tPATH")
+	}
+
+	for _, path := range flags.Args() {
+		resp, err := client.GetContentSummary(ctx, &proto.GetContentSummaryRequest{Path: path})
+		if err != nil {
+			return err
+		}
+
+		if *quotaFlag {
+			quota := resp.GetQuota()
+			namespace, remainingNamespace := formatLimit(quota.GetNamespace(), quota.GetNamespaceUsed())
+			space, remainingSpace := formatLimit(quota.GetSpace(), quota.GetSpaceUsed())
+			fmt.Printf("%s\t%s\t%s\t%s\t", namespace, remainingNamespace, space, remainingSpace)
+		}
+		fmt.Printf("%d\t%d\t%d\t%s\n", resp.GetDirCount(), resp.GetFileCount(), resp.GetLength(), resp.GetPath())
+	}
+
+	return nil
+}
+
+func runQuota(ctx context.Context, s *session, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: dfs quota set|clear|user [args]")
+	}
+
+	client := proto.NewNameClient(s.conn)
+
+	switch args[0] {
+	case "set":
+		flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		namespaceFlag := flags.Uint64("namespace", 0, "Maximum number of files and directories below the directory")
+		spaceFlag := flags.String("space", "0", "Maximum bytes times replicas below the directory, such as 10G")
+		err := flags.Parse(args[1:])
+		if err != nil {
+			return err
+		}
+		if flags.NArg() != 1 {
+			return fmt.Errorf("usage: dfs quota set [-namespace <n>] [-space <size>] <path>")
+		}
+		space, err := parseSize(*spaceFlag)
+		if err != nil {
+			return err
+		}
+		if *namespaceFlag == 0 && space == 0 {
+			return fmt.Errorf("neither namespace nor space quota given, use dfs quota clear <path> to remove them")
+		}
+		_, err = client.SetQuota(ctx, &proto.SetQuotaRequest{Path: flags.Arg(0), Namespace: *namespaceFlag, Space: space})
+		return err
+	case "clear":
+		err := requireArgs(args[1:], "dfs quota clear <path>", 1)
+		if err != nil {
+			return err
+		}
+		_, err = client.SetQuota(ctx, &proto.SetQuotaRequest{Path: args[1]})
+		return err
+	case "user":
+		if len(args) > 3 {
+			return fmt.Errorf("usage: dfs quota user [<user> [<size>]]")
+		}
+		if len(args) == 3 {
+			space, err := parseSize(args[2])
+			if err != nil {
+				return err
+			}
+			_, err = client.SetUserQuota(ctx, &proto.SetUserQuotaRequest{User: args[1], Space: space})
+			return err
+		}
+		user := ""
+		if len(args) == 2 {
+			user = args[1]
+		}
+		resp, err := client.GetUserQuota(ctx, &proto.GetUserQuotaRequest{User: user})
+		if err != nil {
+			return err
+		}
+		space, remainingSpace := formatLimit(resp.GetSpace(), resp.GetSpaceUsed())
+		fmt.Println("SPACE_QUOTA\tREM_SPACE_QUOTA\tSPACE_USED\tUSER")
+		fmt.Printf("%s\t%s\t%d\t%s\n", space, remainingSpace, resp.GetSpaceUsed(), resp.GetUser())
+		return nil
+	default:
+		return fmt.Errorf("unknown quota command: %s", args[0])
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+)
+
+func runSession(ctx context.Context, s *session, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: dfs session list|revoke|revoke-all [args]")
+	}
+
+	client := proto.NewNameClient(s.conn)
+
+	switch args[0] {
+	case "list":
+		resp, err := client.ListSessions(ctx, &proto.ListSessionsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.GetSessions() {
+			fmt.Printf("%d\t%s\t%s\n",
+				info.GetId(),
+				time.Unix(info.GetCreatedAt(), 0).Format(time.RFC3339),
+				time.Unix(info.GetExpiresAt(), 0).Format(time.RFC3339))
+		}
+		return nil
+	case "revoke":
+		err := requireArgs(args[1:], "dfs session revoke <id>", 1)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid session id %s: %w", args[1], err)
+		}
+		_, err = client.RevokeSession(ctx, &proto.RevokeSessionRequest{Id: id})
+		return err
+	case "revoke-all":
+		_, err := client.RevokeAllSessions(ctx, &proto.RevokeAllSessionsRequest{})
+		return err
+	default:
+		return fmt.Errorf("unknown session command: %s", args[0])
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+)
+
+// pathsFlag collects the values of a flag given several times.
+type pathsFlag []string
+
+func (p *pathsFlag) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *pathsFlag) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+// parseOperations parses a comma separated list of read, write, delete and
+// execute.
+func parseOperations(value string) (*proto.Permission, error) {
+	operations := &proto.Permission{}
+
+	for _, operation := range strings.Split(value, ",") {
+		switch strings.TrimSpace(operation) {
+		case "read":
+			operations.Read = true
+		case "write":
+			operations.Write = true
+		case "delete":
+			operations.Delete = true
+		case "execute":
+			operations.Execute = true
+		default:
+			return nil, fmt.Errorf("unknown operation: %s", operation)
+		}
+	}
+
+	return operations, nil
+}
+
+func runToken(ctx context.Context, s *session, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: dfs token create|renew [args]")
+	}
+
+	client := proto.NewNameClient(s.conn)
+
+	switch args[0] {
+	case "create":
+		var paths pathsFlag
+		flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		flags.Var(&paths, "path", "Path the token is limited to, may be given several times")
+		opsFlag := flags.String("ops", "read,execute", "Comma separated operations the token may perform")
+		lifetimeFlag := flags.Duration("lifetime", 1*time.Hour, "Lifetime of the token")
+		renewerFlag := flags.String("renewer", "", "User who may renew the token")
+		err := flags.Parse(args[1:])
+		if err != nil {
+			return err
+		}
+		operations, err := parseOperations(*opsFlag)
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateDelegationToken(ctx, &proto.CreateDelegationTokenRequest{
+			Paths:      paths,
+			Operations: operations,
+			Lifetime:   int64(lifetimeFlag.Seconds()),
+			Renewer:    *renewerFlag,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\t%s\n", resp.GetToken(), time.Unix(resp.GetExpiresAt(), 0).Format(time.RFC3339))
+		return nil
+	case "renew":
+		err := requireArgs(args[1:], "dfs token renew <token>", 1)
+		if err != nil {
+			return err
+		}
+		resp, err := client.RenewDelegationToken(ctx, &proto.RenewDelegationTokenRequest{DelegationToken: args[1]})
+		if err != nil {
+			return err
+		}
+		fmt.Println(time.Unix(resp.GetExpiresAt(), 0).Format(time.RFC3339))
+		return nil
+	default:
+		return fmt.Errorf("unknown token command: %s", args[0])
+	}
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"flag"
 	"fmt"
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/name"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"net"
+	"os"
+	"strings"
 	"time"
 
 	"gorm.io/driver/mysql"
@@ -27,9 +30,46 @@ func main() {
 	dbPoolMaxLifetimeFlag := flag.Duration("db-pool-max-lifetime", 1*time.Hour, "Max Lifetime of Connections in the DB Pool")
 	dbPoolMaxIdleTimeFlag := flag.Duration("db-pool-max-idle-time", 10*time.Minute, "Max Lifetime of Connections in the DB Pool")
 	tokenExpirationFlag := flag.Duration("token-expiration", 24*time.Hour, "Token Expiration duration")
+	tokenCacheTTLFlag := flag.Duration("token-cache-ttl", name.DefaultTokenCacheTTL, "How long validated tokens are cached in memory")
+	delegationTokenMaxLifetimeFlag := flag.Duration("delegation-token-max-lifetime", name.DefaultDelegationTokenMaxLifetime, "How long delegation tokens may be renewed after they were issued")
+	tokenPurgeIntervalFlag := flag.Duration("token-purge-interval", 1*time.Hour, "Interval to delete expired tokens")
+	passwordIterationsFlag := flag.Int("password-iterations", name.DefaultPasswordIterations, "PBKDF2 Iterations for Stored Passwords")
+	passwordMinLengthFlag := flag.Int("password-min-length", name.DefaultPasswordMinLength, "Minimum length of passwords")
+	passwordClassesFlag := flag.String("password-classes", "", "Comma separated character classes passwords need (upper, lower, digit, symbol)")
+	passwordHistoryFlag := flag.Int("password-history", 0, "Number of recent passwords that may not be used again")
+	lockoutMaxFailuresFlag := flag.Int("lockout-max-failures", name.DefaultLockoutMaxFailures, "Failed logins in a row after which a user is locked, never when 0")
+	lockoutDurationFlag := flag.Duration("lockout-duration", name.DefaultLockoutDuration, "How long users are locked")
+	loginBackoffBaseFlag := flag.Duration("login-backoff-base", name.DefaultLoginBackoffBase, "Delay after a failed login of a user or from an IP, doubled by every further failure")
+	loginBackoffMaxFlag := flag.Duration("login-backoff-max", name.DefaultLoginBackoffMax, "Maximum delay after failed logins")
+	superuserGroupFlag := flag.String("superuser-group", name.DefaultSuperuserGroup, "Group whose members have root privileges and may administer users and groups")
+	umaskFlag := flag.String("umask", name.DefaultUmask, "Umask of files created without permissions by users without an umask")
+	bootstrapAdminFlag := flag.String("bootstrap-admin", "", "Admin user to create on first start when no user exists")
+	bootstrapAdminPasswordFlag := flag.String("bootstrap-admin-password", os.Getenv("DFS_BOOTSTRAP_ADMIN_PASSWORD"), "Password of the bootstrap admin user")
+	nodeSecretFlag := flag.String("node-secret", os.Getenv("DFS_NODE_SECRET"), "Cluster Secret to derive node keys from")
+	proxyUsersFlag := flag.String("proxy-users", "", "File with a service account and the users=... and groups=... it may impersonate per line")
+	nodeKeysFlag := flag.String("node-keys", "", "File with a host and a hex encoded key per line")
 	numReplicasFlag := flag.Uint("num-replicas", 1, "Number of replicas")
 	nodeExpirationFlag := flag.Duration("node-expiration", 15*time.Minute, "Node Expiration duration")
 	healingIntervalFlag := flag.Duration("healing-interval", 1*time.Minute, "Healing interval")
+	blockTokenExpirationFlag := flag.Duration("block-token-expiration", name.DefaultBlockTokenExpiration, "Block Access Token Expiration duration")
+	tlsCertFlag := flag.String("tls-cert", "", "TLS Certificate File (TLS is disabled when empty)")
+	tlsKeyFlag := flag.String("tls-key", "", "TLS Key File")
+	tlsCAFlag := flag.String("tls-ca", "", "TLS CA File to verify nodes and clients")
+	tlsVerifyClientFlag := flag.Bool("tls-verify-client", false, "Require client certificates issued by the TLS CA")
+	tlsNodesFlag := flag.String("tls-nodes", "", "Comma separated host names of the nodes authenticated by their client certificates issued by the TLS CA")
+	authProvidersFlag := flag.String("auth-providers", "db", "Comma separated authentication providers tried in order (db, htpasswd, jwt)")
+	htpasswdFileFlag := flag.String("htpasswd-file", "", "htpasswd File with bcrypt or {SHA} hashes for the htpasswd provider")
+	htgroupFileFlag := flag.String("htgroup-file", "", "Group File with 'group: user...' lines for the htpasswd provider")
+	jwksFileFlag := flag.String("jwks-file", "", "JSON Web Key Set File for the jwt provider")
+	jwtIssuerFlag := flag.String("jwt-issuer", "", "Required iss claim for the jwt provider")
+	jwtAudienceFlag := flag.String("jwt-audience", "", "Required aud claim for the jwt provider")
+	jwtUserClaimFlag := flag.String("jwt-user-claim", name.DefaultJWTUserClaim, "Claim holding the user name for the jwt provider")
+	jwtGroupsClaimFlag := flag.String("jwt-groups-claim", name.DefaultJWTGroupsClaim, "Claim holding the groups for the jwt provider")
+	auditFileFlag := flag.String("audit-file", "", "JSON Lines File to append audit events to")
+	auditMaxSizeFlag := flag.Int64("audit-max-size", name.DefaultAuditMaxSize, "Size in bytes after which the audit file is rotated")
+	auditMaxBackupsFlag := flag.Int("audit-max-backups", name.DefaultAuditMaxBackups, "Number of rotated audit files to keep")
+	auditDBFlag := flag.Bool("audit-db", false, "Store audit events in the database too")
+	blockKeyRotationFlag := flag.Duration("block-key-rotation", 1*time.Hour, "Interval to rotate the key signing block access tokens")
 	var dialector gorm.Dialector
 
 	flag.Parse()
@@ -60,6 +100,14 @@ func main() {
 		log.WithError(err).Fatal("Failed to create database")
 	}
 
+	migrations, err := name.Migrate(db)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to migrate data")
+	}
+	for _, migration := range migrations {
+		log.WithField("migration", migration).Info("Applied data migration")
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		log.WithError(err).Fatal("Failed to get SQL DB")
@@ -77,36 +125,171 @@ func main() {
 	}
 	log.Info("Database connection established")
 
+	connectionFactory := proto.NewInsecureConnectionFactory()
+	var serverOpts []grpc.ServerOption
+	var certificates *proto.Certificates
+	if *tlsCertFlag != "" {
+		log.WithField("cert", *tlsCertFlag).Info("Loading TLS certificates")
+		certificates, err = proto.NewCertificates(proto.TLSOpts{
+			CertFile:                 *tlsCertFlag,
+			KeyFile:                  *tlsKeyFlag,
+			CAFile:                   *tlsCAFlag,
+			VerifyClientCertificates: *tlsVerifyClientFlag,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to load TLS certificates")
+		}
+		connectionFactory = proto.NewTLSConnectionFactory(certificates)
+		serverOpts = append(serverOpts, certificates.ServerOption())
+	} else {
+		log.Warn("TLS is disabled, set --tls-cert and --tls-key")
+	}
+
+	passwordClasses, err := name.ParsePasswordClasses(*passwordClassesFlag)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid password classes")
+	}
+
+	lockout := name.LockoutPolicy{
+		MaxFailures: *lockoutMaxFailuresFlag,
+		Duration:    *lockoutDurationFlag,
+		BackoffBase: *loginBackoffBaseFlag,
+		BackoffMax:  *loginBackoffMaxFlag,
+	}
+
 	log.Info("Creating services")
 	securityService, err := name.NewSecurityService(name.SecurityServiceOpts{
-		Logger:           log,
-		DB:               db,
-		TokenExperiation: *tokenExpirationFlag,
+		Logger:                     log,
+		DB:                         db,
+		TokenExperiation:           *tokenExpirationFlag,
+		PasswordIterations:         *passwordIterationsFlag,
+		TokenCacheTTL:              *tokenCacheTTLFlag,
+		DelegationTokenMaxLifetime: *delegationTokenMaxLifetimeFlag,
+		PasswordPolicy: name.PasswordPolicy{
+			MinLength: *passwordMinLengthFlag,
+			Classes:   passwordClasses,
+			History:   *passwordHistoryFlag,
+		},
+		Lockout: lockout,
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to create security service")
 	}
 
+	if *bootstrapAdminFlag != "" {
+		created, err := name.BootstrapAdmin(securityService, *bootstrapAdminFlag, *bootstrapAdminPasswordFlag, *superuserGroupFlag)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to bootstrap admin user")
+		}
+		if created {
+			log.WithField("user", *bootstrapAdminFlag).Info("Created bootstrap admin user")
+		}
+	}
+
 	fileService, err := name.NewFileService(name.FileServiceOpts{
-		Logger: log,
-		DB:     db,
+		Logger:         log,
+		DB:             db,
+		SuperuserGroup: *superuserGroupFlag,
+		Umask:          *umaskFlag,
+		Replication:    *numReplicasFlag,
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to create file service")
 	}
 
+	// A key must outlive the tokens it signed after the next rotation.
+	blockKeyValidity := 2*(*blockKeyRotationFlag) + *blockTokenExpirationFlag
+	blockKeys := blocktoken.NewKeyring()
+	_, err = blockKeys.Rotate(blockKeyValidity)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create block key")
+	}
+
 	log.Info("Creating server")
+	var authenticators []name.Authenticator
+	for _, provider := range strings.Split(*authProvidersFlag, ",") {
+		var authenticator name.Authenticator
+		switch strings.TrimSpace(provider) {
+		case "db":
+			authenticator = name.NewDBAuthenticator(securityService)
+		case name.HtpasswdProvider:
+			authenticator, err = name.NewHtpasswdAuthenticator(name.HtpasswdAuthenticatorOpts{
+				Logger:    log,
+				File:      *htpasswdFileFlag,
+				GroupFile: *htgroupFileFlag,
+			})
+		case name.JWTProvider:
+			authenticator, err = name.NewJWTAuthenticator(name.JWTAuthenticatorOpts{
+				Logger:      log,
+				JWKSFile:    *jwksFileFlag,
+				Issuer:      *jwtIssuerFlag,
+				Audience:    *jwtAudienceFlag,
+				UserClaim:   *jwtUserClaimFlag,
+				GroupsClaim: *jwtGroupsClaimFlag,
+			})
+		default:
+			log.Fatalf("Invalid authentication provider: %s", provider)
+		}
+		if err != nil {
+			log.WithError(err).WithField("provider", provider).Fatal("Failed to create authentication provider")
+		}
+		authenticators = append(authenticators, authenticator)
+	}
+
+	loginLimiter, err := name.NewLoginLimiter(lockout)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create login limiter")
+	}
+
 	server := name.Server{Opts: name.ServerOpts{
-		Logger:          log,
-		SecurityService: securityService,
-		FileService:     fileService}}
+		Logger:               log,
+		SecurityService:      securityService,
+		FileService:          fileService,
+		Authenticator:        name.NewChainAuthenticator(authenticators...),
+		BlockKeys:            blockKeys,
+		BlockTokenExpiration: *blockTokenExpirationFlag,
+		SuperuserGroup:       *superuserGroupFlag,
+		LoginLimiter:         loginLimiter}}
+
+	var auditLog name.AuditLog
+	if *auditFileFlag != "" || *auditDBFlag {
+		auditLogOpts := name.AuditLogOpts{
+			Logger:     log,
+			File:       *auditFileFlag,
+			MaxSize:    *auditMaxSizeFlag,
+			MaxBackups: *auditMaxBackupsFlag,
+		}
+		if *auditDBFlag {
+			auditLogOpts.DB = db
+		}
+		auditLog, err = name.NewAuditLog(auditLogOpts)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create audit log")
+		}
+		defer auditLog.Close()
+	}
+
+	adminServer, err := name.NewAdminServer(name.AdminServerOpts{
+		Logger:               log,
+		SecurityService:      securityService,
+		SuperuserGroup:       *superuserGroupFlag,
+		ClusterSecret:        []byte(*nodeSecretFlag),
+		AuditLog:             auditLog,
+		BlockKeys:            blockKeys,
+		BlockTokenExpiration: *blockTokenExpirationFlag,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create admin server")
+	}
 
 	healingService, err := name.NewHealingService(name.HealingOpts{
-		Logger:            log,
-		NumReplicas:       *numReplicasFlag,
-		FileService:       fileService,
-		NodeExpiration:    *nodeExpirationFlag,
-		ConnectionFactory: proto.NewInsecureConnectionFactory(),
+		Logger:               log,
+		NumReplicas:          *numReplicasFlag,
+		FileService:          fileService,
+		NodeExpiration:       *nodeExpirationFlag,
+		ConnectionFactory:    connectionFactory,
+		BlockKeys:            blockKeys,
+		BlockTokenExpiration: *blockTokenExpirationFlag,
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to create healing service")
@@ -115,6 +298,7 @@ func main() {
 	notificationServer := name.NotificationServer{
 		FileService:    fileService,
 		HealingService: healingService,
+		BlockKeys:      blockKeys,
 	}
 
 	log.WithField("host", *hostFlag).WithField("port", *portFlag).Info("Starting network listener")
@@ -123,9 +307,79 @@ func main() {
 		log.WithError(err).Fatal("Failed to listen")
 	}
 
-	grpcServer := grpc.NewServer()
+	var proxyUsers map[string]name.ProxyUser
+	if *proxyUsersFlag != "" {
+		proxyUsers, err = readProxyUsers(*proxyUsersFlag)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to read proxy users")
+		}
+	}
+
+	authInterceptor, err := name.NewAuthInterceptor(name.AuthInterceptorOpts{
+		Logger:          log,
+		SecurityService: securityService,
+		ProxyUsers:      proxyUsers,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create auth interceptor")
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{authInterceptor.Unary()}
+
+	if auditLog != nil {
+		auditInterceptor, err := name.NewAuditInterceptor(name.AuditInterceptorOpts{
+			Logger:   log,
+			AuditLog: auditLog,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create audit interceptor")
+		}
+		// first, so that calls rejected by the auth interceptors are audited
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{auditInterceptor.Unary()}, unaryInterceptors...)
+	}
+
+	var nodeKeys map[string][]byte
+	if *nodeKeysFlag != "" {
+		nodeKeys, err = readNodeKeys(*nodeKeysFlag)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to read node keys")
+		}
+	}
+
+	var certificateNodes []string
+	for _, node := range strings.Split(*tlsNodesFlag, ",") {
+		if strings.TrimSpace(node) != "" {
+			certificateNodes = append(certificateNodes, strings.TrimSpace(node))
+		}
+	}
+	if len(certificateNodes) > 0 && (certificates == nil || *tlsCAFlag == "") {
+		log.Fatal("--tls-nodes requires --tls-cert, --tls-key and --tls-ca")
+	}
+
+	if *nodeSecretFlag != "" || len(nodeKeys) > 0 || len(certificateNodes) > 0 {
+		nodeAuthInterceptor, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{
+			Logger:           log,
+			ClusterSecret:    []byte(*nodeSecretFlag),
+			NodeKeys:         nodeKeys,
+			CertificateNodes: certificateNodes,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create node auth interceptor")
+		}
+		unaryInterceptors = append(unaryInterceptors, nodeAuthInterceptor.Unary())
+	} else {
+		// nodes fetch the block keys through the authenticated notification
+		// service, without it they could not verify any block access token
+		log.Fatal("Node authentication is not configured, set --node-secret, --node-keys or --tls-nodes")
+	}
+
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream()))
+	grpcServer := grpc.NewServer(serverOpts...)
 	proto.RegisterNameServer(grpcServer, server)
 	proto.RegisterNotificationServer(grpcServer, notificationServer)
+	proto.RegisterAdminServer(grpcServer, adminServer)
 
 	go func() {
 		t := time.NewTicker(*healingIntervalFlag)
@@ -137,6 +391,30 @@ func main() {
 		}
 	}()
 
+	go func() {
+		t := time.NewTicker(*blockKeyRotationFlag)
+		for range t.C {
+			key, err := blockKeys.Rotate(blockKeyValidity)
+			if err != nil {
+				log.WithError(err).Error("Failed to rotate block key")
+				continue
+			}
+			log.WithField("key-id", key.ID).Info("Rotated block key")
+		}
+	}()
+
+	go func() {
+		t := time.NewTicker(*tokenPurgeIntervalFlag)
+		for range t.C {
+			deleted, err := securityService.PurgeExpiredTokens()
+			if err != nil {
+				log.WithError(err).Error("Failed to purge expired tokens")
+				continue
+			}
+			log.WithField("deleted", deleted).Debug("Purged expired tokens")
+		}
+	}()
+
 	log.Info("Starting gRPC server")
 	if err := grpcServer.Serve(listener); err != nil {
 		log.WithError(err).Fatal("Failed to serve gRPC server")
@@ -155,13 +433,39 @@ func createDB(dialector gorm.Dialector) (*gorm.DB, error) {
 		name.User{},
 		name.Group{},
 		name.Token{},
+		name.APIKey{},
+		name.PasswordHistory{},
 		name.Permissions{},
 		name.FileInfo{},
 		name.Permission{},
-		name.BlockInfo{})
+		name.BlockInfo{},
+		name.ACLEntry{},
+		name.UserQuota{},
+		name.BlockReservation{},
+		name.AuditEvent{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to auto migrate: %w", err)
 	}
 
 	return db, nil
 }
+
+func readNodeKeys(path string) (map[string][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return name.ParseNodeKeys(f)
+}
+
+func readProxyUsers(path string) (map[string]name.ProxyUser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return name.ParseProxyUsers(f)
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
+	"encoding/hex"
 	"flag"
 	"fmt"
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/node"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/sirupsen/logrus"
@@ -11,18 +14,38 @@ import (
 	"gorm.io/gorm"
 	"net"
 	"os"
+	"strings"
 	"time"
 )
 
 func main() {
 	logLevelFlag := flag.String("log-level", "info", "Log Level")
 	nameNodeFlag := flag.String("name-node", "localhost:53035", "Name Node Address")
-	dirFlag := flag.String("dir", "./", "Node Directory")
+	storeFlag := flag.String("store", "local", "Block Store (local, memory, s3)")
+	dirFlag := flag.String("dir", "./", "Node Directories (comma separated list of volumes)")
+	s3EndpointFlag := flag.String("s3-endpoint", "", "S3 Endpoint URL")
+	s3RegionFlag := flag.String("s3-region", "us-east-1", "S3 Region")
+	s3BucketFlag := flag.String("s3-bucket", "", "S3 Bucket")
+	s3PrefixFlag := flag.String("s3-prefix", "", "S3 Key Prefix, unique per node (the node host when empty)")
+	s3AccessKeyFlag := flag.String("s3-access-key", os.Getenv("DFS_S3_ACCESS_KEY"), "S3 Access Key")
+	s3SecretKeyFlag := flag.String("s3-secret-key", os.Getenv("DFS_S3_SECRET_KEY"), "S3 Secret Key")
+	volumePolicyFlag := flag.String("volume-policy", node.VolumePolicyRoundRobin, "Volume Choosing Policy (round-robin, available-space)")
+	volumeFailureThresholdFlag := flag.Uint("volume-failure-threshold", node.DefaultVolumeFailureThreshold, "Number of I/O errors after which a volume is considered failed")
+	reservedBytesFlag := flag.Uint64("reserved-bytes", 1<<30, "Bytes kept free on each volume")
+	reservedPercentFlag := flag.Float64("reserved-percent", 0, "Percent of each volume kept free")
+	replicationRateFlag := flag.Uint64("replication-rate", 0, "Bytes per second for block copies to other nodes (0 is unlimited)")
+	nodeKeyFlag := flag.String("node-key", os.Getenv("DFS_NODE_KEY"), "Hex Encoded Key of this Node issued by the name node")
+	nodeSecretFlag := flag.String("node-secret", os.Getenv("DFS_NODE_SECRET"), "Cluster Secret to derive the node key from")
 	dsnFlag := flag.String("dsn", "nodeserver.db", "Data Source Name (DSN) for the database")
 	hostFlag := flag.String("host", "localhost:55055", "Node Host")
 	reportIntervalFlag := flag.Duration("report-interval", 10*time.Minute, "Report Interval")
 	healthCheckIntervalFlag := flag.Duration("health-check-interval", 1*time.Hour, "Health Check Interval")
 	crcCheckIntervalFlag := flag.Duration("crc-check-interval", 24*time.Hour, "CRC Check Interval")
+	tlsCertFlag := flag.String("tls-cert", "", "TLS Certificate File, also presented to the name node and other nodes (TLS is disabled when empty)")
+	tlsKeyFlag := flag.String("tls-key", "", "TLS Key File")
+	tlsCAFlag := flag.String("tls-ca", "", "TLS CA File to verify the name node and other nodes")
+	tlsVerifyClientFlag := flag.Bool("tls-verify-client", false, "Require client certificates issued by the TLS CA")
+	blockKeyRefreshIntervalFlag := flag.Duration("block-key-refresh-interval", 5*time.Minute, "Interval to fetch the block keys from the name node")
 
 	flag.Parse()
 
@@ -34,10 +57,46 @@ func main() {
 
 	log.SetLevel(logLevel)
 
-	log.WithField("dir-path", *dirFlag).Info("Checking directory")
-	_, err = os.Stat(*dirFlag)
-	if err != nil {
-		log.WithError(err).WithField("dir", *dirFlag).Fatal("Directory does not exist")
+	var stores []node.BlockStore
+	switch *storeFlag {
+	case "local":
+		for _, dir := range strings.Split(*dirFlag, ",") {
+			dir = strings.TrimSpace(dir)
+			if len(dir) == 0 {
+				continue
+			}
+
+			log.WithField("dir-path", dir).Info("Checking directory")
+			store, err := node.NewLocalBlockStore(dir)
+			if err != nil {
+				log.WithError(err).WithField("dir", dir).Fatal("Directory does not exist")
+			}
+
+			stores = append(stores, store)
+		}
+	case "memory":
+		stores = append(stores, node.NewMemoryBlockStore("memory"))
+	case "s3":
+		log.WithField("endpoint", *s3EndpointFlag).WithField("bucket", *s3BucketFlag).Info("Creating S3 block store")
+		s3Prefix := *s3PrefixFlag
+		if s3Prefix == "" {
+			s3Prefix = *hostFlag
+		}
+		store, err := node.NewS3BlockStore(node.S3BlockStoreOpts{
+			Endpoint:  *s3EndpointFlag,
+			Region:    *s3RegionFlag,
+			Bucket:    *s3BucketFlag,
+			Prefix:    s3Prefix,
+			AccessKey: *s3AccessKeyFlag,
+			SecretKey: *s3SecretKeyFlag,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create S3 block store")
+		}
+
+		stores = append(stores, store)
+	default:
+		log.Fatalf("Invalid block store: %s", *storeFlag)
 	}
 
 	log.WithField("dns", *dsnFlag).Info("Opening database")
@@ -46,21 +105,66 @@ func main() {
 		log.WithError(err).Fatal("Failed to create database")
 	}
 
-	connectionFactory := proto.NewInsecureConnectionFactory()
+	var certificates *proto.Certificates
+	if *tlsCertFlag != "" {
+		log.WithField("cert", *tlsCertFlag).Info("Loading TLS certificates")
+		certificates, err = proto.NewCertificates(proto.TLSOpts{
+			CertFile:                 *tlsCertFlag,
+			KeyFile:                  *tlsKeyFlag,
+			CAFile:                   *tlsCAFlag,
+			VerifyClientCertificates: *tlsVerifyClientFlag,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to load TLS certificates")
+		}
+	} else {
+		log.Warn("TLS is disabled, set --tls-cert and --tls-key")
+	}
+
+	createConnectionFactory := func(opts ...grpc.DialOption) proto.ConnectionFactory {
+		if certificates != nil {
+			return proto.NewTLSConnectionFactory(certificates, opts...)
+		}
+		return proto.NewInsecureConnectionFactory(opts...)
+	}
+
+	connectionFactory := createConnectionFactory()
+
+	var nameNodeOpts []grpc.DialOption
+	var nodeKey []byte
+	switch {
+	case *nodeKeyFlag != "":
+		nodeKey, err = hex.DecodeString(*nodeKeyFlag)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid node key")
+		}
+		nameNodeOpts = append(nameNodeOpts, grpc.WithUnaryInterceptor(proto.NodeAuthUnaryClientInterceptor(*hostFlag, nodeKey)))
+	case *nodeSecretFlag != "":
+		nodeKey = proto.DeriveNodeKey([]byte(*nodeSecretFlag), *hostFlag)
+		nameNodeOpts = append(nameNodeOpts, grpc.WithUnaryInterceptor(proto.NodeAuthUnaryClientInterceptor(*hostFlag, nodeKey)))
+	case certificates != nil:
+		log.Info("No node key configured, the name node has to identify this node by its certificate")
+	default:
+		log.Fatal("No node key configured, set --node-key or --node-secret, or --tls-cert and --tls-key for a name node that trusts client certificates")
+	}
 
 	log.WithField("name-node", *nameNodeFlag).Info("Connecting to name node")
-	conn, err := connectionFactory.CreateConnection(*nameNodeFlag)
+	conn, err := createConnectionFactory(nameNodeOpts...).CreateConnection(*nameNodeFlag)
 	if err != nil {
 		log.WithError(err).Fatal("Failed to connect to name node")
 	}
 	client := proto.NewNotificationClient(conn)
 
 	serviceOpts := node.BlockServiceOpts{
-		Logger:             log,
-		Host:               *hostFlag,
-		DB:                 db,
-		Dir:                *dirFlag,
-		NotificationClient: client,
+		Logger:                 log,
+		Host:                   *hostFlag,
+		DB:                     db,
+		Stores:                 stores,
+		VolumePolicy:           *volumePolicyFlag,
+		VolumeFailureThreshold: *volumeFailureThresholdFlag,
+		ReservedBytes:          *reservedBytesFlag,
+		ReservedPercent:        *reservedPercentFlag,
+		NotificationClient:     client,
 	}
 
 	log.Info("Creating block service")
@@ -69,6 +173,16 @@ func main() {
 		log.WithError(err).Fatal("Failed to create block service")
 	}
 
+	log.Info("Fetching block keys from name node")
+	blockKeys := blocktoken.NewKeyring()
+	refreshBlockKeys := func() error {
+		return node.FetchBlockKeys(context.Background(), client, *hostFlag, nodeKey, blockKeys)
+	}
+	err = refreshBlockKeys()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to fetch block keys")
+	}
+
 	log.Info("Reporting to name node")
 	err = blockService.Report()
 	if err != nil {
@@ -77,9 +191,13 @@ func main() {
 
 	log.Info("Creating server")
 	nodeServer, err := node.NewServer(node.ServerOpts{
-		Logger:            log,
-		BlockService:      blockService,
-		ConnectionFactory: connectionFactory,
+		Logger:              log,
+		Host:                *hostFlag,
+		BlockService:        blockService,
+		ConnectionFactory:   connectionFactory,
+		ReplicationThrottle: node.NewThrottle(*replicationRateFlag),
+		BlockKeys:           blockKeys,
+		RefreshBlockKeys:    refreshBlockKeys,
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to create server")
@@ -91,7 +209,12 @@ func main() {
 		log.WithError(err).Fatal("Failed to listen")
 	}
 
-	grpcServer := grpc.NewServer()
+	var serverOpts []grpc.ServerOption
+	if certificates != nil {
+		serverOpts = append(serverOpts, certificates.ServerOption())
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	proto.RegisterNodeServer(grpcServer, nodeServer)
 
 	log.Info("Starting grpc server")
@@ -123,13 +246,23 @@ func main() {
 		}
 	}()
 
+	go func() {
+		ticker := time.NewTicker(*blockKeyRefreshIntervalFlag)
+		for range ticker.C {
+			err := refreshBlockKeys()
+			if err != nil {
+				log.WithError(err).Warn("Failed to refresh block keys")
+			}
+		}
+	}()
+
 	go func() {
 		ticker := time.NewTicker(*crcCheckIntervalFlag)
 		for range ticker.C {
 			log.Info("Validating CRC")
 			err := blockService.ValidateCRC()
 			if err != nil {
-				log.WithError(err).Fatal("validate CRC failed")
+				log.WithError(err).Error("validate CRC failed")
 			}
 			log.Info("Finished validating CRC")
 		}
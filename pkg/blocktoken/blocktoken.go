@@ -0,0 +1,250 @@
+package blocktoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Op is a set of operations a block access token allows.
+type Op uint8
+
+const (
+	OpRead Op = 1 << iota
+	OpWrite
+	OpDelete
+	// OpAdmin allows calls that concern a whole node rather than a block.
+	// Its tokens name the host of the node in place of a block id.
+	OpAdmin
+)
+
+const keyLength = 32
+
+var ErrInvalidToken = errors.New("invalid block access token")
+var ErrUnknownKey = errors.New("unknown block key")
+var ErrBadSignature = errors.New("bad signature")
+
+func (o Op) String() string {
+	var names []string
+
+	if o&OpRead != 0 {
+		names = append(names, "read")
+	}
+	if o&OpWrite != 0 {
+		names = append(names, "write")
+	}
+	if o&OpDelete != 0 {
+		names = append(names, "delete")
+	}
+	if o&OpAdmin != 0 {
+		names = append(names, "admin")
+	}
+
+	return strings.Join(names, ",")
+}
+
+// Key is a secret shared by the name server and the nodes to sign block
+// access tokens.
+type Key struct {
+	ID        uint64
+	Secret    []byte
+	ExpiresAt time.Time
+}
+
+// Claims is what a block access token grants. An empty path allows writing
+// the block for any path. Length is the most bytes a write may store, the
+// space the name server charged for the block.
+type Claims struct {
+	KeyID     uint64 `json:"k"`
+	BlockID   string `json:"b"`
+	Path      string `json:"p,omitempty"`
+	Ops       Op     `json:"o"`
+	Length    uint64 `json:"l,omitempty"`
+	ExpiresAt int64  `json:"e"`
+}
+
+// Keyring holds the keys used to issue and verify block access tokens. The
+// name server rotates its keys, the nodes replace theirs with the keys of the
+// name server.
+type Keyring struct {
+	lock    sync.RWMutex
+	keys    map[uint64]Key
+	current uint64
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{keys: map[uint64]Key{}}
+}
+
+// Rotate creates a new key valid for the given duration that is used to issue
+// tokens from now on, and drops expired keys. The ids of a new keyring start at
+// a random number, so that a restarted name server does not issue tokens with
+// the ids of its former keys, which the nodes still hold with other secrets.
+func (k *Keyring) Rotate(validFor time.Duration) (Key, error) {
+	secret := make([]byte, keyLength)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return Key{}, fmt.Errorf("could not create block key: %w", err)
+	}
+
+	start := make([]byte, 8)
+	_, err = rand.Read(start)
+	if err != nil {
+		return Key{}, fmt.Errorf("could not create block key id: %w", err)
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.current == 0 {
+		// leaves room for rotations without overflowing
+		k.current = binary.BigEndian.Uint64(start) >> 16
+	}
+
+	now := time.Now()
+	key := Key{
+		ID:        k.current + 1,
+		Secret:    secret,
+		ExpiresAt: now.Add(validFor),
+	}
+
+	for id, existing := range k.keys {
+		if existing.ExpiresAt.Before(now) {
+			delete(k.keys, id)
+		}
+	}
+
+	k.keys[key.ID] = key
+	k.current = key.ID
+
+	return key, nil
+}
+
+// Set replaces all keys.
+func (k *Keyring) Set(keys []Key) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.keys = map[uint64]Key{}
+	k.current = 0
+
+	for _, key := range keys {
+		k.keys[key.ID] = key
+		if key.ID > k.current {
+			k.current = key.ID
+		}
+	}
+}
+
+// Keys returns all keys that are not expired, ordered by id.
+func (k *Keyring) Keys() []Key {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	now := time.Now()
+	var keys []Key
+
+	for _, key := range k.keys {
+		if !key.ExpiresAt.Before(now) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	return keys
+}
+
+func sign(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Issue creates a token for the block valid for the given duration. Writes
+// with the token may store at most length bytes.
+func (k *Keyring) Issue(blockID string, path string, ops Op, length uint64, validFor time.Duration) (string, error) {
+	k.lock.RLock()
+	key, found := k.keys[k.current]
+	k.lock.RUnlock()
+
+	if !found {
+		return "", fmt.Errorf("no block key available")
+	}
+
+	claims := Claims{
+		KeyID:     key.ID,
+		BlockID:   blockID,
+		Path:      path,
+		Ops:       ops,
+		Length:    length,
+		ExpiresAt: time.Now().Add(validFor).Unix(),
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not encode claims: %w", err)
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(data)
+
+	return payload + "." + sign(key.Secret, payload), nil
+}
+
+// Verify checks that the token is signed by a known key, is not expired and
+// grants op on the block.
+func (k *Keyring) Verify(token string, blockID string, op Op) (Claims, error) {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found {
+		return Claims{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	claims := Claims{}
+	err = json.Unmarshal(data, &claims)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	k.lock.RLock()
+	key, found := k.keys[claims.KeyID]
+	k.lock.RUnlock()
+
+	if !found {
+		return Claims{}, fmt.Errorf("%w: %w %d", ErrInvalidToken, ErrUnknownKey, claims.KeyID)
+	}
+
+	if !hmac.Equal([]byte(sign(key.Secret, payload)), []byte(signature)) {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidToken, ErrBadSignature)
+	}
+
+	if time.Now().Unix() > claims.ExpiresAt {
+		return Claims{}, fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+
+	if claims.BlockID != blockID {
+		return Claims{}, fmt.Errorf("%w: not valid for block %s", ErrInvalidToken, blockID)
+	}
+
+	if claims.Ops&op != op {
+		return Claims{}, fmt.Errorf("%w: does not allow %s", ErrInvalidToken, op)
+	}
+
+	return claims, nil
+}
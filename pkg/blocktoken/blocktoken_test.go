@@ -0,0 +1,113 @@
+package blocktoken_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring_IssueAndVerify(t *testing.T) {
+	keys := blocktoken.NewKeyring()
+	_, err := keys.Rotate(time.Hour)
+	assert.NoError(t, err)
+
+	token, err := keys.Issue("block1", "/file", blocktoken.OpRead|blocktoken.OpWrite, 0, time.Minute)
+	assert.NoError(t, err)
+
+	claims, err := keys.Verify(token, "block1", blocktoken.OpRead)
+	assert.NoError(t, err)
+	assert.Equal(t, "/file", claims.Path)
+
+	_, err = keys.Verify(token, "block1", blocktoken.OpRead|blocktoken.OpWrite)
+	assert.NoError(t, err)
+
+	_, err = keys.Verify(token, "block1", blocktoken.OpDelete)
+	assert.ErrorIs(t, err, blocktoken.ErrInvalidToken)
+
+	_, err = keys.Verify(token, "block2", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrInvalidToken)
+
+	_, err = keys.Verify(token+"x", "block1", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrInvalidToken)
+
+	_, err = keys.Verify("garbage", "block1", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrInvalidToken)
+}
+
+func TestKeyring_Expired(t *testing.T) {
+	keys := blocktoken.NewKeyring()
+	_, err := keys.Rotate(time.Hour)
+	assert.NoError(t, err)
+
+	token, err := keys.Issue("block1", "/file", blocktoken.OpRead, 0, -time.Minute)
+	assert.NoError(t, err)
+
+	_, err = keys.Verify(token, "block1", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrInvalidToken)
+}
+
+func TestKeyring_Rotate(t *testing.T) {
+	issuer := blocktoken.NewKeyring()
+	_, err := issuer.Issue("block1", "/file", blocktoken.OpRead, 0, time.Minute)
+	assert.Error(t, err)
+
+	first, err := issuer.Rotate(time.Hour)
+	assert.NoError(t, err)
+	oldToken, err := issuer.Issue("block1", "/file", blocktoken.OpRead, 0, time.Minute)
+	assert.NoError(t, err)
+
+	second, err := issuer.Rotate(time.Hour)
+	assert.NoError(t, err)
+	assert.Greater(t, second.ID, first.ID)
+	newToken, err := issuer.Issue("block1", "/file", blocktoken.OpRead, 0, time.Minute)
+	assert.NoError(t, err)
+
+	verifier := blocktoken.NewKeyring()
+	verifier.Set([]blocktoken.Key{first})
+
+	_, err = verifier.Verify(oldToken, "block1", blocktoken.OpRead)
+	assert.NoError(t, err)
+
+	_, err = verifier.Verify(newToken, "block1", blocktoken.OpRead)
+	assert.True(t, errors.Is(err, blocktoken.ErrUnknownKey))
+
+	verifier.Set(issuer.Keys())
+
+	_, err = verifier.Verify(oldToken, "block1", blocktoken.OpRead)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(newToken, "block1", blocktoken.OpRead)
+	assert.NoError(t, err)
+}
+
+func TestKeyring_Restart(t *testing.T) {
+	issuer := blocktoken.NewKeyring()
+	first, err := issuer.Rotate(time.Hour)
+	assert.NoError(t, err)
+
+	verifier := blocktoken.NewKeyring()
+	verifier.Set(issuer.Keys())
+
+	// a restarted issuer starts with other key ids
+	restarted := blocktoken.NewKeyring()
+	key, err := restarted.Rotate(time.Hour)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ID, key.ID)
+
+	token, err := restarted.Issue("block1", "/file", blocktoken.OpRead, 0, time.Minute)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token, "block1", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrUnknownKey)
+
+	// a key with a known id but another secret
+	verifier.Set([]blocktoken.Key{{ID: key.ID, Secret: first.Secret, ExpiresAt: first.ExpiresAt}})
+	_, err = verifier.Verify(token, "block1", blocktoken.OpRead)
+	assert.ErrorIs(t, err, blocktoken.ErrBadSignature)
+}
+
+func TestOp_String(t *testing.T) {
+	assert.Equal(t, "read,delete", (blocktoken.OpRead | blocktoken.OpDelete).String())
+	assert.Equal(t, "admin", blocktoken.OpAdmin.String())
+}
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	proto "github.com/cirglo.com/dfs/pkg/proto"
+
+	time "time"
 )
 
 // FileService is an autogenerated mock type for the FileService type
@@ -23,7 +25,7 @@ func (_m *FileService) EXPECT() *FileService_Expecter {
 }
 
 // CreateDir provides a mock function with given fields: p, path, perms
-func (_m *FileService) CreateDir(p name.Principal, path string, perms name.Permissions) (name.FileInfo, error) {
+func (_m *FileService) CreateDir(p name.Principal, path string, perms *name.Permissions) (name.FileInfo, error) {
 	ret := _m.Called(p, path, perms)
 
 	if len(ret) == 0 {
@@ -32,16 +34,16 @@ func (_m *FileService) CreateDir(p name.Principal, path string, perms name.Permi
 
 	var r0 name.FileInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(name.Principal, string, name.Permissions) (name.FileInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(name.Principal, string, *name.Permissions) (name.FileInfo, error)); ok {
 		return rf(p, path, perms)
 	}
-	if rf, ok := ret.Get(0).(func(name.Principal, string, name.Permissions) name.FileInfo); ok {
+	if rf, ok := ret.Get(0).(func(name.Principal, string, *name.Permissions) name.FileInfo); ok {
 		r0 = rf(p, path, perms)
 	} else {
 		r0 = ret.Get(0).(name.FileInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(name.Principal, string, name.Permissions) error); ok {
+	if rf, ok := ret.Get(1).(func(name.Principal, string, *name.Permissions) error); ok {
 		r1 = rf(p, path, perms)
 	} else {
 		r1 = ret.Error(1)
@@ -58,14 +60,14 @@ type FileService_CreateDir_Call struct {
 // CreateDir is a helper method to define mock.On call
 //   - p name.Principal
 //   - path string
-//   - perms name.Permissions
+//   - perms *name.Permissions
 func (_e *FileService_Expecter) CreateDir(p interface{}, path interface{}, perms interface{}) *FileService_CreateDir_Call {
 	return &FileService_CreateDir_Call{Call: _e.mock.On("CreateDir", p, path, perms)}
 }
 
-func (_c *FileService_CreateDir_Call) Run(run func(p name.Principal, path string, perms name.Permissions)) *FileService_CreateDir_Call {
+func (_c *FileService_CreateDir_Call) Run(run func(p name.Principal, path string, perms *name.Permissions)) *FileService_CreateDir_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(name.Principal), args[1].(string), args[2].(name.Permissions))
+		run(args[0].(name.Principal), args[1].(string), args[2].(*name.Permissions))
 	})
 	return _c
 }
@@ -75,13 +77,13 @@ func (_c *FileService_CreateDir_Call) Return(_a0 name.FileInfo, _a1 error) *File
 	return _c
 }
 
-func (_c *FileService_CreateDir_Call) RunAndReturn(run func(name.Principal, string, name.Permissions) (name.FileInfo, error)) *FileService_CreateDir_Call {
+func (_c *FileService_CreateDir_Call) RunAndReturn(run func(name.Principal, string, *name.Permissions) (name.FileInfo, error)) *FileService_CreateDir_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // CreateFile provides a mock function with given fields: p, path, perms
-func (_m *FileService) CreateFile(p name.Principal, path string, perms name.Permissions) (name.FileInfo, error) {
+func (_m *FileService) CreateFile(p name.Principal, path string, perms *name.Permissions) (name.FileInfo, error) {
 	ret := _m.Called(p, path, perms)
 
 	if len(ret) == 0 {
@@ -90,16 +92,16 @@ func (_m *FileService) CreateFile(p name.Principal, path string, perms name.Perm
 
 	var r0 name.FileInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(name.Principal, string, name.Permissions) (name.FileInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(name.Principal, string, *name.Permissions) (name.FileInfo, error)); ok {
 		return rf(p, path, perms)
 	}
-	if rf, ok := ret.Get(0).(func(name.Principal, string, name.Permissions) name.FileInfo); ok {
+	if rf, ok := ret.Get(0).(func(name.Principal, string, *name.Permissions) name.FileInfo); ok {
 		r0 = rf(p, path, perms)
 	} else {
 		r0 = ret.Get(0).(name.FileInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(name.Principal, string, name.Permissions) error); ok {
+	if rf, ok := ret.Get(1).(func(name.Principal, string, *name.Permissions) error); ok {
 		r1 = rf(p, path, perms)
 	} else {
 		r1 = ret.Error(1)
@@ -116,14 +118,14 @@ type FileService_CreateFile_Call struct {
 // CreateFile is a helper method to define mock.On call
 //   - p name.Principal
 //   - path string
-//   - perms name.Permissions
+//   - perms *name.Permissions
 func (_e *FileService_Expecter) CreateFile(p interface{}, path interface{}, perms interface{}) *FileService_CreateFile_Call {
 	return &FileService_CreateFile_Call{Call: _e.mock.On("CreateFile", p, path, perms)}
 }
 
-func (_c *FileService_CreateFile_Call) Run(run func(p name.Principal, path string, perms name.Permissions)) *FileService_CreateFile_Call {
+func (_c *FileService_CreateFile_Call) Run(run func(p name.Principal, path string, perms *name.Permissions)) *FileService_CreateFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(name.Principal), args[1].(string), args[2].(name.Permissions))
+		run(args[0].(name.Principal), args[1].(string), args[2].(*name.Permissions))
 	})
 	return _c
 }
@@ -133,7 +135,7 @@ func (_c *FileService_CreateFile_Call) Return(_a0 name.FileInfo, _a1 error) *Fil
 	return _c
 }
 
-func (_c *FileService_CreateFile_Call) RunAndReturn(run func(name.Principal, string, name.Permissions) (name.FileInfo, error)) *FileService_CreateFile_Call {
+func (_c *FileService_CreateFile_Call) RunAndReturn(run func(name.Principal, string, *name.Permissions) (name.FileInfo, error)) *FileService_CreateFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -232,6 +234,65 @@ func (_c *FileService_DeleteFile_Call) RunAndReturn(run func(name.Principal, str
 	return _c
 }
 
+// GetACL provides a mock function with given fields: p, path
+func (_m *FileService) GetACL(p name.Principal, path string) ([]name.ACLEntry, error) {
+	ret := _m.Called(p, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACL")
+	}
+
+	var r0 []name.ACLEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string) ([]name.ACLEntry, error)); ok {
+		return rf(p, path)
+	}
+	if rf, ok := ret.Get(0).(func(name.Principal, string) []name.ACLEntry); ok {
+		r0 = rf(p, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]name.ACLEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(name.Principal, string) error); ok {
+		r1 = rf(p, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FileService_GetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACL'
+type FileService_GetACL_Call struct {
+	*mock.Call
+}
+
+// GetACL is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+func (_e *FileService_Expecter) GetACL(p interface{}, path interface{}) *FileService_GetACL_Call {
+	return &FileService_GetACL_Call{Call: _e.mock.On("GetACL", p, path)}
+}
+
+func (_c *FileService_GetACL_Call) Run(run func(p name.Principal, path string)) *FileService_GetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FileService_GetACL_Call) Return(_a0 []name.ACLEntry, _a1 error) *FileService_GetACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FileService_GetACL_Call) RunAndReturn(run func(name.Principal, string) ([]name.ACLEntry, error)) *FileService_GetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllBlockInfos provides a mock function with no fields
 func (_m *FileService) GetAllBlockInfos() ([]name.BlockInfo, error) {
 	ret := _m.Called()
@@ -348,6 +409,120 @@ func (_c *FileService_GetBlockInfos_Call) RunAndReturn(run func(name.Principal,
 	return _c
 }
 
+// GetContentSummary provides a mock function with given fields: p, path
+func (_m *FileService) GetContentSummary(p name.Principal, path string) (name.ContentSummary, error) {
+	ret := _m.Called(p, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentSummary")
+	}
+
+	var r0 name.ContentSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string) (name.ContentSummary, error)); ok {
+		return rf(p, path)
+	}
+	if rf, ok := ret.Get(0).(func(name.Principal, string) name.ContentSummary); ok {
+		r0 = rf(p, path)
+	} else {
+		r0 = ret.Get(0).(name.ContentSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(name.Principal, string) error); ok {
+		r1 = rf(p, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FileService_GetContentSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentSummary'
+type FileService_GetContentSummary_Call struct {
+	*mock.Call
+}
+
+// GetContentSummary is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+func (_e *FileService_Expecter) GetContentSummary(p interface{}, path interface{}) *FileService_GetContentSummary_Call {
+	return &FileService_GetContentSummary_Call{Call: _e.mock.On("GetContentSummary", p, path)}
+}
+
+func (_c *FileService_GetContentSummary_Call) Run(run func(p name.Principal, path string)) *FileService_GetContentSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FileService_GetContentSummary_Call) Return(_a0 name.ContentSummary, _a1 error) *FileService_GetContentSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FileService_GetContentSummary_Call) RunAndReturn(run func(name.Principal, string) (name.ContentSummary, error)) *FileService_GetContentSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserQuota provides a mock function with given fields: p, user
+func (_m *FileService) GetUserQuota(p name.Principal, user string) (name.UserQuota, error) {
+	ret := _m.Called(p, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserQuota")
+	}
+
+	var r0 name.UserQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string) (name.UserQuota, error)); ok {
+		return rf(p, user)
+	}
+	if rf, ok := ret.Get(0).(func(name.Principal, string) name.UserQuota); ok {
+		r0 = rf(p, user)
+	} else {
+		r0 = ret.Get(0).(name.UserQuota)
+	}
+
+	if rf, ok := ret.Get(1).(func(name.Principal, string) error); ok {
+		r1 = rf(p, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FileService_GetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserQuota'
+type FileService_GetUserQuota_Call struct {
+	*mock.Call
+}
+
+// GetUserQuota is a helper method to define mock.On call
+//   - p name.Principal
+//   - user string
+func (_e *FileService_Expecter) GetUserQuota(p interface{}, user interface{}) *FileService_GetUserQuota_Call {
+	return &FileService_GetUserQuota_Call{Call: _e.mock.On("GetUserQuota", p, user)}
+}
+
+func (_c *FileService_GetUserQuota_Call) Run(run func(p name.Principal, user string)) *FileService_GetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FileService_GetUserQuota_Call) Return(_a0 name.UserQuota, _a1 error) *FileService_GetUserQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FileService_GetUserQuota_Call) RunAndReturn(run func(name.Principal, string) (name.UserQuota, error)) *FileService_GetUserQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: p, path
 func (_m *FileService) List(p name.Principal, path string) ([]name.FileInfo, error) {
 	ret := _m.Called(p, path)
@@ -591,6 +766,351 @@ func (_c *FileService_NotifyBlockRemoved_Call) RunAndReturn(run func(*proto.Noti
 	return _c
 }
 
+// RemoveACL provides a mock function with given fields: p, path, entries
+func (_m *FileService) RemoveACL(p name.Principal, path string, entries []name.ACLEntry) error {
+	ret := _m.Called(p, path, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveACL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, []name.ACLEntry) error); ok {
+		r0 = rf(p, path, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_RemoveACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveACL'
+type FileService_RemoveACL_Call struct {
+	*mock.Call
+}
+
+// RemoveACL is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+//   - entries []name.ACLEntry
+func (_e *FileService_Expecter) RemoveACL(p interface{}, path interface{}, entries interface{}) *FileService_RemoveACL_Call {
+	return &FileService_RemoveACL_Call{Call: _e.mock.On("RemoveACL", p, path, entries)}
+}
+
+func (_c *FileService_RemoveACL_Call) Run(run func(p name.Principal, path string, entries []name.ACLEntry)) *FileService_RemoveACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].([]name.ACLEntry))
+	})
+	return _c
+}
+
+func (_c *FileService_RemoveACL_Call) Return(_a0 error) *FileService_RemoveACL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_RemoveACL_Call) RunAndReturn(run func(name.Principal, string, []name.ACLEntry) error) *FileService_RemoveACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveBlock provides a mock function with given fields: path, blockID, length, expiresAt
+func (_m *FileService) ReserveBlock(path string, blockID string, length uint64, expiresAt time.Time) error {
+	ret := _m.Called(path, blockID, length, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, uint64, time.Time) error); ok {
+		r0 = rf(path, blockID, length, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_ReserveBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveBlock'
+type FileService_ReserveBlock_Call struct {
+	*mock.Call
+}
+
+// ReserveBlock is a helper method to define mock.On call
+//   - path string
+//   - blockID string
+//   - length uint64
+//   - expiresAt time.Time
+func (_e *FileService_Expecter) ReserveBlock(path interface{}, blockID interface{}, length interface{}, expiresAt interface{}) *FileService_ReserveBlock_Call {
+	return &FileService_ReserveBlock_Call{Call: _e.mock.On("ReserveBlock", path, blockID, length, expiresAt)}
+}
+
+func (_c *FileService_ReserveBlock_Call) Run(run func(path string, blockID string, length uint64, expiresAt time.Time)) *FileService_ReserveBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(uint64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FileService_ReserveBlock_Call) Return(_a0 error) *FileService_ReserveBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_ReserveBlock_Call) RunAndReturn(run func(string, string, uint64, time.Time) error) *FileService_ReserveBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetACL provides a mock function with given fields: p, path, entries
+func (_m *FileService) SetACL(p name.Principal, path string, entries []name.ACLEntry) error {
+	ret := _m.Called(p, path, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetACL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, []name.ACLEntry) error); ok {
+		r0 = rf(p, path, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_SetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetACL'
+type FileService_SetACL_Call struct {
+	*mock.Call
+}
+
+// SetACL is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+//   - entries []name.ACLEntry
+func (_e *FileService_Expecter) SetACL(p interface{}, path interface{}, entries interface{}) *FileService_SetACL_Call {
+	return &FileService_SetACL_Call{Call: _e.mock.On("SetACL", p, path, entries)}
+}
+
+func (_c *FileService_SetACL_Call) Run(run func(p name.Principal, path string, entries []name.ACLEntry)) *FileService_SetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].([]name.ACLEntry))
+	})
+	return _c
+}
+
+func (_c *FileService_SetACL_Call) Return(_a0 error) *FileService_SetACL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_SetACL_Call) RunAndReturn(run func(name.Principal, string, []name.ACLEntry) error) *FileService_SetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOwner provides a mock function with given fields: p, path, owner, group, recursive
+func (_m *FileService) SetOwner(p name.Principal, path string, owner string, group string, recursive bool) error {
+	ret := _m.Called(p, path, owner, group, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOwner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, string, string, bool) error); ok {
+		r0 = rf(p, path, owner, group, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_SetOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOwner'
+type FileService_SetOwner_Call struct {
+	*mock.Call
+}
+
+// SetOwner is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+//   - owner string
+//   - group string
+//   - recursive bool
+func (_e *FileService_Expecter) SetOwner(p interface{}, path interface{}, owner interface{}, group interface{}, recursive interface{}) *FileService_SetOwner_Call {
+	return &FileService_SetOwner_Call{Call: _e.mock.On("SetOwner", p, path, owner, group, recursive)}
+}
+
+func (_c *FileService_SetOwner_Call) Run(run func(p name.Principal, path string, owner string, group string, recursive bool)) *FileService_SetOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *FileService_SetOwner_Call) Return(_a0 error) *FileService_SetOwner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_SetOwner_Call) RunAndReturn(run func(name.Principal, string, string, string, bool) error) *FileService_SetOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPermission provides a mock function with given fields: p, path, ownerPermission, groupPermission, otherPermission, sticky, setGID, recursive
+func (_m *FileService) SetPermission(p name.Principal, path string, ownerPermission name.Permission, groupPermission name.Permission, otherPermission name.Permission, sticky bool, setGID bool, recursive bool) error {
+	ret := _m.Called(p, path, ownerPermission, groupPermission, otherPermission, sticky, setGID, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, name.Permission, name.Permission, name.Permission, bool, bool, bool) error); ok {
+		r0 = rf(p, path, ownerPermission, groupPermission, otherPermission, sticky, setGID, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_SetPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPermission'
+type FileService_SetPermission_Call struct {
+	*mock.Call
+}
+
+// SetPermission is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+//   - ownerPermission name.Permission
+//   - groupPermission name.Permission
+//   - otherPermission name.Permission
+//   - sticky bool
+//   - setGID bool
+//   - recursive bool
+func (_e *FileService_Expecter) SetPermission(p interface{}, path interface{}, ownerPermission interface{}, groupPermission interface{}, otherPermission interface{}, sticky interface{}, setGID interface{}, recursive interface{}) *FileService_SetPermission_Call {
+	return &FileService_SetPermission_Call{Call: _e.mock.On("SetPermission", p, path, ownerPermission, groupPermission, otherPermission, sticky, setGID, recursive)}
+}
+
+func (_c *FileService_SetPermission_Call) Run(run func(p name.Principal, path string, ownerPermission name.Permission, groupPermission name.Permission, otherPermission name.Permission, sticky bool, setGID bool, recursive bool)) *FileService_SetPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].(name.Permission), args[3].(name.Permission), args[4].(name.Permission), args[5].(bool), args[6].(bool), args[7].(bool))
+	})
+	return _c
+}
+
+func (_c *FileService_SetPermission_Call) Return(_a0 error) *FileService_SetPermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_SetPermission_Call) RunAndReturn(run func(name.Principal, string, name.Permission, name.Permission, name.Permission, bool, bool, bool) error) *FileService_SetPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQuota provides a mock function with given fields: p, path, namespace, space
+func (_m *FileService) SetQuota(p name.Principal, path string, namespace uint64, space uint64) error {
+	ret := _m.Called(p, path, namespace, space)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, uint64, uint64) error); ok {
+		r0 = rf(p, path, namespace, space)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_SetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQuota'
+type FileService_SetQuota_Call struct {
+	*mock.Call
+}
+
+// SetQuota is a helper method to define mock.On call
+//   - p name.Principal
+//   - path string
+//   - namespace uint64
+//   - space uint64
+func (_e *FileService_Expecter) SetQuota(p interface{}, path interface{}, namespace interface{}, space interface{}) *FileService_SetQuota_Call {
+	return &FileService_SetQuota_Call{Call: _e.mock.On("SetQuota", p, path, namespace, space)}
+}
+
+func (_c *FileService_SetQuota_Call) Run(run func(p name.Principal, path string, namespace uint64, space uint64)) *FileService_SetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *FileService_SetQuota_Call) Return(_a0 error) *FileService_SetQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_SetQuota_Call) RunAndReturn(run func(name.Principal, string, uint64, uint64) error) *FileService_SetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserQuota provides a mock function with given fields: p, user, space
+func (_m *FileService) SetUserQuota(p name.Principal, user string, space uint64) error {
+	ret := _m.Called(p, user, space)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(name.Principal, string, uint64) error); ok {
+		r0 = rf(p, user, space)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FileService_SetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserQuota'
+type FileService_SetUserQuota_Call struct {
+	*mock.Call
+}
+
+// SetUserQuota is a helper method to define mock.On call
+//   - p name.Principal
+//   - user string
+//   - space uint64
+func (_e *FileService_Expecter) SetUserQuota(p interface{}, user interface{}, space interface{}) *FileService_SetUserQuota_Call {
+	return &FileService_SetUserQuota_Call{Call: _e.mock.On("SetUserQuota", p, user, space)}
+}
+
+func (_c *FileService_SetUserQuota_Call) Run(run func(p name.Principal, user string, space uint64)) *FileService_SetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.Principal), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *FileService_SetUserQuota_Call) Return(_a0 error) *FileService_SetUserQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileService_SetUserQuota_Call) RunAndReturn(run func(name.Principal, string, uint64) error) *FileService_SetUserQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stat provides a mock function with given fields: p, path
 func (_m *FileService) Stat(p name.Principal, path string) (name.FileInfo, error) {
 	ret := _m.Called(p, path)
@@ -101,6 +101,40 @@ func (_c *HealingService_NotifyNodeAlive_Call) RunAndReturn(run func(string, tim
 	return _c
 }
 
+// NotifyNodeFull provides a mock function with given fields: host, full
+func (_m *HealingService) NotifyNodeFull(host string, full bool) {
+	_m.Called(host, full)
+}
+
+// HealingService_NotifyNodeFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyNodeFull'
+type HealingService_NotifyNodeFull_Call struct {
+	*mock.Call
+}
+
+// NotifyNodeFull is a helper method to define mock.On call
+//   - host string
+//   - full bool
+func (_e *HealingService_Expecter) NotifyNodeFull(host interface{}, full interface{}) *HealingService_NotifyNodeFull_Call {
+	return &HealingService_NotifyNodeFull_Call{Call: _e.mock.On("NotifyNodeFull", host, full)}
+}
+
+func (_c *HealingService_NotifyNodeFull_Call) Run(run func(host string, full bool)) *HealingService_NotifyNodeFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *HealingService_NotifyNodeFull_Call) Return() *HealingService_NotifyNodeFull_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealingService_NotifyNodeFull_Call) RunAndReturn(run func(string, bool)) *HealingService_NotifyNodeFull_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewHealingService creates a new instance of HealingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHealingService(t interface {
@@ -25,6 +25,80 @@ func (_m *NameClient) EXPECT() *NameClient_Expecter {
 	return &NameClient_Expecter{mock: &_m.Mock}
 }
 
+// CreateDelegationToken provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) CreateDelegationToken(ctx context.Context, in *proto.CreateDelegationTokenRequest, opts ...grpc.CallOption) (*proto.CreateDelegationTokenResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelegationToken")
+	}
+
+	var r0 *proto.CreateDelegationTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateDelegationTokenRequest, ...grpc.CallOption) (*proto.CreateDelegationTokenResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateDelegationTokenRequest, ...grpc.CallOption) *proto.CreateDelegationTokenResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.CreateDelegationTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateDelegationTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_CreateDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelegationToken'
+type NameClient_CreateDelegationToken_Call struct {
+	*mock.Call
+}
+
+// CreateDelegationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.CreateDelegationTokenRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) CreateDelegationToken(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_CreateDelegationToken_Call {
+	return &NameClient_CreateDelegationToken_Call{Call: _e.mock.On("CreateDelegationToken",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_CreateDelegationToken_Call) Run(run func(ctx context.Context, in *proto.CreateDelegationTokenRequest, opts ...grpc.CallOption)) *NameClient_CreateDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.CreateDelegationTokenRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_CreateDelegationToken_Call) Return(_a0 *proto.CreateDelegationTokenResponse, _a1 error) *NameClient_CreateDelegationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_CreateDelegationToken_Call) RunAndReturn(run func(context.Context, *proto.CreateDelegationTokenRequest, ...grpc.CallOption) (*proto.CreateDelegationTokenResponse, error)) *NameClient_CreateDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateDir provides a mock function with given fields: ctx, in, opts
 func (_m *NameClient) CreateDir(ctx context.Context, in *proto.CreateDirRequest, opts ...grpc.CallOption) (*proto.CreateDirResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -321,8 +395,8 @@ func (_c *NameClient_DeleteFile_Call) RunAndReturn(run func(context.Context, *pr
 	return _c
 }
 
-// List provides a mock function with given fields: ctx, in, opts
-func (_m *NameClient) List(ctx context.Context, in *proto.ListRequest, opts ...grpc.CallOption) (*proto.ListResponse, error) {
+// GetACL provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) GetACL(ctx context.Context, in *proto.GetACLRequest, opts ...grpc.CallOption) (*proto.GetACLResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -333,23 +407,23 @@ func (_m *NameClient) List(ctx context.Context, in *proto.ListRequest, opts ...g
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for GetACL")
 	}
 
-	var r0 *proto.ListResponse
+	var r0 *proto.GetACLResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) (*proto.ListResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetACLRequest, ...grpc.CallOption) (*proto.GetACLResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) *proto.ListResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetACLRequest, ...grpc.CallOption) *proto.GetACLResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*proto.ListResponse)
+			r0 = ret.Get(0).(*proto.GetACLResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetACLRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -358,21 +432,21 @@ func (_m *NameClient) List(ctx context.Context, in *proto.ListRequest, opts ...g
 	return r0, r1
 }
 
-// NameClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type NameClient_List_Call struct {
+// NameClient_GetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACL'
+type NameClient_GetACL_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// GetACL is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proto.ListRequest
+//   - in *proto.GetACLRequest
 //   - opts ...grpc.CallOption
-func (_e *NameClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_List_Call {
-	return &NameClient_List_Call{Call: _e.mock.On("List",
+func (_e *NameClient_Expecter) GetACL(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_GetACL_Call {
+	return &NameClient_GetACL_Call{Call: _e.mock.On("GetACL",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *NameClient_List_Call) Run(run func(ctx context.Context, in *proto.ListRequest, opts ...grpc.CallOption)) *NameClient_List_Call {
+func (_c *NameClient_GetACL_Call) Run(run func(ctx context.Context, in *proto.GetACLRequest, opts ...grpc.CallOption)) *NameClient_GetACL_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -380,23 +454,23 @@ func (_c *NameClient_List_Call) Run(run func(ctx context.Context, in *proto.List
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proto.ListRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proto.GetACLRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *NameClient_List_Call) Return(_a0 *proto.ListResponse, _a1 error) *NameClient_List_Call {
+func (_c *NameClient_GetACL_Call) Return(_a0 *proto.GetACLResponse, _a1 error) *NameClient_GetACL_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NameClient_List_Call) RunAndReturn(run func(context.Context, *proto.ListRequest, ...grpc.CallOption) (*proto.ListResponse, error)) *NameClient_List_Call {
+func (_c *NameClient_GetACL_Call) RunAndReturn(run func(context.Context, *proto.GetACLRequest, ...grpc.CallOption) (*proto.GetACLResponse, error)) *NameClient_GetACL_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function with given fields: ctx, in, opts
-func (_m *NameClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginResponse, error) {
+// GetBlockAccessToken provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) GetBlockAccessToken(ctx context.Context, in *proto.GetBlockAccessTokenRequest, opts ...grpc.CallOption) (*proto.GetBlockAccessTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -407,23 +481,23 @@ func (_m *NameClient) Login(ctx context.Context, in *proto.LoginRequest, opts ..
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for GetBlockAccessToken")
 	}
 
-	var r0 *proto.LoginResponse
+	var r0 *proto.GetBlockAccessTokenResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) (*proto.LoginResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockAccessTokenRequest, ...grpc.CallOption) (*proto.GetBlockAccessTokenResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) *proto.LoginResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockAccessTokenRequest, ...grpc.CallOption) *proto.GetBlockAccessTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*proto.LoginResponse)
+			r0 = ret.Get(0).(*proto.GetBlockAccessTokenResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetBlockAccessTokenRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -432,21 +506,21 @@ func (_m *NameClient) Login(ctx context.Context, in *proto.LoginRequest, opts ..
 	return r0, r1
 }
 
-// NameClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type NameClient_Login_Call struct {
+// NameClient_GetBlockAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockAccessToken'
+type NameClient_GetBlockAccessToken_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// GetBlockAccessToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proto.LoginRequest
+//   - in *proto.GetBlockAccessTokenRequest
 //   - opts ...grpc.CallOption
-func (_e *NameClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_Login_Call {
-	return &NameClient_Login_Call{Call: _e.mock.On("Login",
+func (_e *NameClient_Expecter) GetBlockAccessToken(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_GetBlockAccessToken_Call {
+	return &NameClient_GetBlockAccessToken_Call{Call: _e.mock.On("GetBlockAccessToken",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *NameClient_Login_Call) Run(run func(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption)) *NameClient_Login_Call {
+func (_c *NameClient_GetBlockAccessToken_Call) Run(run func(ctx context.Context, in *proto.GetBlockAccessTokenRequest, opts ...grpc.CallOption)) *NameClient_GetBlockAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -454,23 +528,23 @@ func (_c *NameClient_Login_Call) Run(run func(ctx context.Context, in *proto.Log
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proto.LoginRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proto.GetBlockAccessTokenRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *NameClient_Login_Call) Return(_a0 *proto.LoginResponse, _a1 error) *NameClient_Login_Call {
+func (_c *NameClient_GetBlockAccessToken_Call) Return(_a0 *proto.GetBlockAccessTokenResponse, _a1 error) *NameClient_GetBlockAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NameClient_Login_Call) RunAndReturn(run func(context.Context, *proto.LoginRequest, ...grpc.CallOption) (*proto.LoginResponse, error)) *NameClient_Login_Call {
+func (_c *NameClient_GetBlockAccessToken_Call) RunAndReturn(run func(context.Context, *proto.GetBlockAccessTokenRequest, ...grpc.CallOption) (*proto.GetBlockAccessTokenResponse, error)) *NameClient_GetBlockAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Logout provides a mock function with given fields: ctx, in, opts
-func (_m *NameClient) Logout(ctx context.Context, in *proto.LogoutRequest, opts ...grpc.CallOption) (*proto.LogoutResponse, error) {
+// GetContentSummary provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) GetContentSummary(ctx context.Context, in *proto.GetContentSummaryRequest, opts ...grpc.CallOption) (*proto.GetContentSummaryResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -481,23 +555,23 @@ func (_m *NameClient) Logout(ctx context.Context, in *proto.LogoutRequest, opts
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Logout")
+		panic("no return value specified for GetContentSummary")
 	}
 
-	var r0 *proto.LogoutResponse
+	var r0 *proto.GetContentSummaryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) (*proto.LogoutResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetContentSummaryRequest, ...grpc.CallOption) (*proto.GetContentSummaryResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) *proto.LogoutResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetContentSummaryRequest, ...grpc.CallOption) *proto.GetContentSummaryResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*proto.LogoutResponse)
+			r0 = ret.Get(0).(*proto.GetContentSummaryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetContentSummaryRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -506,21 +580,21 @@ func (_m *NameClient) Logout(ctx context.Context, in *proto.LogoutRequest, opts
 	return r0, r1
 }
 
-// NameClient_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
-type NameClient_Logout_Call struct {
+// NameClient_GetContentSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentSummary'
+type NameClient_GetContentSummary_Call struct {
 	*mock.Call
 }
 
-// Logout is a helper method to define mock.On call
+// GetContentSummary is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proto.LogoutRequest
+//   - in *proto.GetContentSummaryRequest
 //   - opts ...grpc.CallOption
-func (_e *NameClient_Expecter) Logout(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_Logout_Call {
-	return &NameClient_Logout_Call{Call: _e.mock.On("Logout",
+func (_e *NameClient_Expecter) GetContentSummary(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_GetContentSummary_Call {
+	return &NameClient_GetContentSummary_Call{Call: _e.mock.On("GetContentSummary",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *NameClient_Logout_Call) Run(run func(ctx context.Context, in *proto.LogoutRequest, opts ...grpc.CallOption)) *NameClient_Logout_Call {
+func (_c *NameClient_GetContentSummary_Call) Run(run func(ctx context.Context, in *proto.GetContentSummaryRequest, opts ...grpc.CallOption)) *NameClient_GetContentSummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -528,17 +602,1053 @@ func (_c *NameClient_Logout_Call) Run(run func(ctx context.Context, in *proto.Lo
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proto.LogoutRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proto.GetContentSummaryRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *NameClient_Logout_Call) Return(_a0 *proto.LogoutResponse, _a1 error) *NameClient_Logout_Call {
+func (_c *NameClient_GetContentSummary_Call) Return(_a0 *proto.GetContentSummaryResponse, _a1 error) *NameClient_GetContentSummary_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NameClient_Logout_Call) RunAndReturn(run func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) (*proto.LogoutResponse, error)) *NameClient_Logout_Call {
+func (_c *NameClient_GetContentSummary_Call) RunAndReturn(run func(context.Context, *proto.GetContentSummaryRequest, ...grpc.CallOption) (*proto.GetContentSummaryResponse, error)) *NameClient_GetContentSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserQuota provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) GetUserQuota(ctx context.Context, in *proto.GetUserQuotaRequest, opts ...grpc.CallOption) (*proto.GetUserQuotaResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserQuota")
+	}
+
+	var r0 *proto.GetUserQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetUserQuotaRequest, ...grpc.CallOption) (*proto.GetUserQuotaResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetUserQuotaRequest, ...grpc.CallOption) *proto.GetUserQuotaResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetUserQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetUserQuotaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_GetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserQuota'
+type NameClient_GetUserQuota_Call struct {
+	*mock.Call
+}
+
+// GetUserQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.GetUserQuotaRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) GetUserQuota(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_GetUserQuota_Call {
+	return &NameClient_GetUserQuota_Call{Call: _e.mock.On("GetUserQuota",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_GetUserQuota_Call) Run(run func(ctx context.Context, in *proto.GetUserQuotaRequest, opts ...grpc.CallOption)) *NameClient_GetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.GetUserQuotaRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_GetUserQuota_Call) Return(_a0 *proto.GetUserQuotaResponse, _a1 error) *NameClient_GetUserQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_GetUserQuota_Call) RunAndReturn(run func(context.Context, *proto.GetUserQuotaRequest, ...grpc.CallOption) (*proto.GetUserQuotaResponse, error)) *NameClient_GetUserQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) List(ctx context.Context, in *proto.ListRequest, opts ...grpc.CallOption) (*proto.ListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *proto.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) (*proto.ListResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) *proto.ListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NameClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.ListRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_List_Call {
+	return &NameClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_List_Call) Run(run func(ctx context.Context, in *proto.ListRequest, opts ...grpc.CallOption)) *NameClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.ListRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_List_Call) Return(_a0 *proto.ListResponse, _a1 error) *NameClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_List_Call) RunAndReturn(run func(context.Context, *proto.ListRequest, ...grpc.CallOption) (*proto.ListResponse, error)) *NameClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) ListSessions(ctx context.Context, in *proto.ListSessionsRequest, opts ...grpc.CallOption) (*proto.ListSessionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *proto.ListSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListSessionsRequest, ...grpc.CallOption) (*proto.ListSessionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListSessionsRequest, ...grpc.CallOption) *proto.ListSessionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.ListSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type NameClient_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.ListSessionsRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) ListSessions(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_ListSessions_Call {
+	return &NameClient_ListSessions_Call{Call: _e.mock.On("ListSessions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_ListSessions_Call) Run(run func(ctx context.Context, in *proto.ListSessionsRequest, opts ...grpc.CallOption)) *NameClient_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.ListSessionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_ListSessions_Call) Return(_a0 *proto.ListSessionsResponse, _a1 error) *NameClient_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_ListSessions_Call) RunAndReturn(run func(context.Context, *proto.ListSessionsRequest, ...grpc.CallOption) (*proto.ListSessionsResponse, error)) *NameClient_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *proto.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) (*proto.LoginResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) *proto.LoginResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.LoginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type NameClient_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.LoginRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_Login_Call {
+	return &NameClient_Login_Call{Call: _e.mock.On("Login",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_Login_Call) Run(run func(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption)) *NameClient_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.LoginRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_Login_Call) Return(_a0 *proto.LoginResponse, _a1 error) *NameClient_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_Login_Call) RunAndReturn(run func(context.Context, *proto.LoginRequest, ...grpc.CallOption) (*proto.LoginResponse, error)) *NameClient_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) Logout(ctx context.Context, in *proto.LogoutRequest, opts ...grpc.CallOption) (*proto.LogoutResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 *proto.LogoutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) (*proto.LogoutResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) *proto.LogoutResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.LogoutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type NameClient_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.LogoutRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) Logout(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_Logout_Call {
+	return &NameClient_Logout_Call{Call: _e.mock.On("Logout",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_Logout_Call) Run(run func(ctx context.Context, in *proto.LogoutRequest, opts ...grpc.CallOption)) *NameClient_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.LogoutRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_Logout_Call) Return(_a0 *proto.LogoutResponse, _a1 error) *NameClient_Logout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_Logout_Call) RunAndReturn(run func(context.Context, *proto.LogoutRequest, ...grpc.CallOption) (*proto.LogoutResponse, error)) *NameClient_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveACL provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) RemoveACL(ctx context.Context, in *proto.RemoveACLRequest, opts ...grpc.CallOption) (*proto.RemoveACLResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveACL")
+	}
+
+	var r0 *proto.RemoveACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RemoveACLRequest, ...grpc.CallOption) (*proto.RemoveACLResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RemoveACLRequest, ...grpc.CallOption) *proto.RemoveACLResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RemoveACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RemoveACLRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_RemoveACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveACL'
+type NameClient_RemoveACL_Call struct {
+	*mock.Call
+}
+
+// RemoveACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.RemoveACLRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) RemoveACL(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_RemoveACL_Call {
+	return &NameClient_RemoveACL_Call{Call: _e.mock.On("RemoveACL",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_RemoveACL_Call) Run(run func(ctx context.Context, in *proto.RemoveACLRequest, opts ...grpc.CallOption)) *NameClient_RemoveACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.RemoveACLRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_RemoveACL_Call) Return(_a0 *proto.RemoveACLResponse, _a1 error) *NameClient_RemoveACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_RemoveACL_Call) RunAndReturn(run func(context.Context, *proto.RemoveACLRequest, ...grpc.CallOption) (*proto.RemoveACLResponse, error)) *NameClient_RemoveACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewDelegationToken provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) RenewDelegationToken(ctx context.Context, in *proto.RenewDelegationTokenRequest, opts ...grpc.CallOption) (*proto.RenewDelegationTokenResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewDelegationToken")
+	}
+
+	var r0 *proto.RenewDelegationTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RenewDelegationTokenRequest, ...grpc.CallOption) (*proto.RenewDelegationTokenResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RenewDelegationTokenRequest, ...grpc.CallOption) *proto.RenewDelegationTokenResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RenewDelegationTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RenewDelegationTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_RenewDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewDelegationToken'
+type NameClient_RenewDelegationToken_Call struct {
+	*mock.Call
+}
+
+// RenewDelegationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.RenewDelegationTokenRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) RenewDelegationToken(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_RenewDelegationToken_Call {
+	return &NameClient_RenewDelegationToken_Call{Call: _e.mock.On("RenewDelegationToken",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_RenewDelegationToken_Call) Run(run func(ctx context.Context, in *proto.RenewDelegationTokenRequest, opts ...grpc.CallOption)) *NameClient_RenewDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.RenewDelegationTokenRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_RenewDelegationToken_Call) Return(_a0 *proto.RenewDelegationTokenResponse, _a1 error) *NameClient_RenewDelegationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_RenewDelegationToken_Call) RunAndReturn(run func(context.Context, *proto.RenewDelegationTokenRequest, ...grpc.CallOption) (*proto.RenewDelegationTokenResponse, error)) *NameClient_RenewDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) RevokeAllSessions(ctx context.Context, in *proto.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*proto.RevokeAllSessionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 *proto.RevokeAllSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeAllSessionsRequest, ...grpc.CallOption) (*proto.RevokeAllSessionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeAllSessionsRequest, ...grpc.CallOption) *proto.RevokeAllSessionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RevokeAllSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RevokeAllSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type NameClient_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.RevokeAllSessionsRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) RevokeAllSessions(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_RevokeAllSessions_Call {
+	return &NameClient_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_RevokeAllSessions_Call) Run(run func(ctx context.Context, in *proto.RevokeAllSessionsRequest, opts ...grpc.CallOption)) *NameClient_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.RevokeAllSessionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_RevokeAllSessions_Call) Return(_a0 *proto.RevokeAllSessionsResponse, _a1 error) *NameClient_RevokeAllSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_RevokeAllSessions_Call) RunAndReturn(run func(context.Context, *proto.RevokeAllSessionsRequest, ...grpc.CallOption) (*proto.RevokeAllSessionsResponse, error)) *NameClient_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) RevokeSession(ctx context.Context, in *proto.RevokeSessionRequest, opts ...grpc.CallOption) (*proto.RevokeSessionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *proto.RevokeSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeSessionRequest, ...grpc.CallOption) (*proto.RevokeSessionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeSessionRequest, ...grpc.CallOption) *proto.RevokeSessionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RevokeSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RevokeSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type NameClient_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.RevokeSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) RevokeSession(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_RevokeSession_Call {
+	return &NameClient_RevokeSession_Call{Call: _e.mock.On("RevokeSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_RevokeSession_Call) Run(run func(ctx context.Context, in *proto.RevokeSessionRequest, opts ...grpc.CallOption)) *NameClient_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.RevokeSessionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_RevokeSession_Call) Return(_a0 *proto.RevokeSessionResponse, _a1 error) *NameClient_RevokeSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_RevokeSession_Call) RunAndReturn(run func(context.Context, *proto.RevokeSessionRequest, ...grpc.CallOption) (*proto.RevokeSessionResponse, error)) *NameClient_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetACL provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) SetACL(ctx context.Context, in *proto.SetACLRequest, opts ...grpc.CallOption) (*proto.SetACLResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetACL")
+	}
+
+	var r0 *proto.SetACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetACLRequest, ...grpc.CallOption) (*proto.SetACLResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetACLRequest, ...grpc.CallOption) *proto.SetACLResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetACLRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_SetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetACL'
+type NameClient_SetACL_Call struct {
+	*mock.Call
+}
+
+// SetACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetACLRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) SetACL(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_SetACL_Call {
+	return &NameClient_SetACL_Call{Call: _e.mock.On("SetACL",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_SetACL_Call) Run(run func(ctx context.Context, in *proto.SetACLRequest, opts ...grpc.CallOption)) *NameClient_SetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetACLRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_SetACL_Call) Return(_a0 *proto.SetACLResponse, _a1 error) *NameClient_SetACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_SetACL_Call) RunAndReturn(run func(context.Context, *proto.SetACLRequest, ...grpc.CallOption) (*proto.SetACLResponse, error)) *NameClient_SetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOwner provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) SetOwner(ctx context.Context, in *proto.SetOwnerRequest, opts ...grpc.CallOption) (*proto.SetOwnerResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOwner")
+	}
+
+	var r0 *proto.SetOwnerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetOwnerRequest, ...grpc.CallOption) (*proto.SetOwnerResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetOwnerRequest, ...grpc.CallOption) *proto.SetOwnerResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetOwnerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetOwnerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_SetOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOwner'
+type NameClient_SetOwner_Call struct {
+	*mock.Call
+}
+
+// SetOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetOwnerRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) SetOwner(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_SetOwner_Call {
+	return &NameClient_SetOwner_Call{Call: _e.mock.On("SetOwner",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_SetOwner_Call) Run(run func(ctx context.Context, in *proto.SetOwnerRequest, opts ...grpc.CallOption)) *NameClient_SetOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetOwnerRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_SetOwner_Call) Return(_a0 *proto.SetOwnerResponse, _a1 error) *NameClient_SetOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_SetOwner_Call) RunAndReturn(run func(context.Context, *proto.SetOwnerRequest, ...grpc.CallOption) (*proto.SetOwnerResponse, error)) *NameClient_SetOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPermission provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) SetPermission(ctx context.Context, in *proto.SetPermissionRequest, opts ...grpc.CallOption) (*proto.SetPermissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPermission")
+	}
+
+	var r0 *proto.SetPermissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetPermissionRequest, ...grpc.CallOption) (*proto.SetPermissionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetPermissionRequest, ...grpc.CallOption) *proto.SetPermissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetPermissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_SetPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPermission'
+type NameClient_SetPermission_Call struct {
+	*mock.Call
+}
+
+// SetPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetPermissionRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) SetPermission(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_SetPermission_Call {
+	return &NameClient_SetPermission_Call{Call: _e.mock.On("SetPermission",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_SetPermission_Call) Run(run func(ctx context.Context, in *proto.SetPermissionRequest, opts ...grpc.CallOption)) *NameClient_SetPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetPermissionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_SetPermission_Call) Return(_a0 *proto.SetPermissionResponse, _a1 error) *NameClient_SetPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_SetPermission_Call) RunAndReturn(run func(context.Context, *proto.SetPermissionRequest, ...grpc.CallOption) (*proto.SetPermissionResponse, error)) *NameClient_SetPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQuota provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) SetQuota(ctx context.Context, in *proto.SetQuotaRequest, opts ...grpc.CallOption) (*proto.SetQuotaResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQuota")
+	}
+
+	var r0 *proto.SetQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetQuotaRequest, ...grpc.CallOption) (*proto.SetQuotaResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetQuotaRequest, ...grpc.CallOption) *proto.SetQuotaResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetQuotaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_SetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQuota'
+type NameClient_SetQuota_Call struct {
+	*mock.Call
+}
+
+// SetQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetQuotaRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) SetQuota(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_SetQuota_Call {
+	return &NameClient_SetQuota_Call{Call: _e.mock.On("SetQuota",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_SetQuota_Call) Run(run func(ctx context.Context, in *proto.SetQuotaRequest, opts ...grpc.CallOption)) *NameClient_SetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetQuotaRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_SetQuota_Call) Return(_a0 *proto.SetQuotaResponse, _a1 error) *NameClient_SetQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_SetQuota_Call) RunAndReturn(run func(context.Context, *proto.SetQuotaRequest, ...grpc.CallOption) (*proto.SetQuotaResponse, error)) *NameClient_SetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserQuota provides a mock function with given fields: ctx, in, opts
+func (_m *NameClient) SetUserQuota(ctx context.Context, in *proto.SetUserQuotaRequest, opts ...grpc.CallOption) (*proto.SetUserQuotaResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserQuota")
+	}
+
+	var r0 *proto.SetUserQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetUserQuotaRequest, ...grpc.CallOption) (*proto.SetUserQuotaResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetUserQuotaRequest, ...grpc.CallOption) *proto.SetUserQuotaResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetUserQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetUserQuotaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameClient_SetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserQuota'
+type NameClient_SetUserQuota_Call struct {
+	*mock.Call
+}
+
+// SetUserQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetUserQuotaRequest
+//   - opts ...grpc.CallOption
+func (_e *NameClient_Expecter) SetUserQuota(ctx interface{}, in interface{}, opts ...interface{}) *NameClient_SetUserQuota_Call {
+	return &NameClient_SetUserQuota_Call{Call: _e.mock.On("SetUserQuota",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NameClient_SetUserQuota_Call) Run(run func(ctx context.Context, in *proto.SetUserQuotaRequest, opts ...grpc.CallOption)) *NameClient_SetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetUserQuotaRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NameClient_SetUserQuota_Call) Return(_a0 *proto.SetUserQuotaResponse, _a1 error) *NameClient_SetUserQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameClient_SetUserQuota_Call) RunAndReturn(run func(context.Context, *proto.SetUserQuotaRequest, ...grpc.CallOption) (*proto.SetUserQuotaResponse, error)) *NameClient_SetUserQuota_Call {
 	_c.Call.Return(run)
 	return _c
 }
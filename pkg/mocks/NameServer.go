@@ -22,6 +22,65 @@ func (_m *NameServer) EXPECT() *NameServer_Expecter {
 	return &NameServer_Expecter{mock: &_m.Mock}
 }
 
+// CreateDelegationToken provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) CreateDelegationToken(_a0 context.Context, _a1 *proto.CreateDelegationTokenRequest) (*proto.CreateDelegationTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelegationToken")
+	}
+
+	var r0 *proto.CreateDelegationTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateDelegationTokenRequest) (*proto.CreateDelegationTokenResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateDelegationTokenRequest) *proto.CreateDelegationTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.CreateDelegationTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateDelegationTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_CreateDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelegationToken'
+type NameServer_CreateDelegationToken_Call struct {
+	*mock.Call
+}
+
+// CreateDelegationToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.CreateDelegationTokenRequest
+func (_e *NameServer_Expecter) CreateDelegationToken(_a0 interface{}, _a1 interface{}) *NameServer_CreateDelegationToken_Call {
+	return &NameServer_CreateDelegationToken_Call{Call: _e.mock.On("CreateDelegationToken", _a0, _a1)}
+}
+
+func (_c *NameServer_CreateDelegationToken_Call) Run(run func(_a0 context.Context, _a1 *proto.CreateDelegationTokenRequest)) *NameServer_CreateDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.CreateDelegationTokenRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_CreateDelegationToken_Call) Return(_a0 *proto.CreateDelegationTokenResponse, _a1 error) *NameServer_CreateDelegationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_CreateDelegationToken_Call) RunAndReturn(run func(context.Context, *proto.CreateDelegationTokenRequest) (*proto.CreateDelegationTokenResponse, error)) *NameServer_CreateDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateDir provides a mock function with given fields: _a0, _a1
 func (_m *NameServer) CreateDir(_a0 context.Context, _a1 *proto.CreateDirRequest) (*proto.CreateDirResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -258,6 +317,242 @@ func (_c *NameServer_DeleteFile_Call) RunAndReturn(run func(context.Context, *pr
 	return _c
 }
 
+// GetACL provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) GetACL(_a0 context.Context, _a1 *proto.GetACLRequest) (*proto.GetACLResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACL")
+	}
+
+	var r0 *proto.GetACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetACLRequest) (*proto.GetACLResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetACLRequest) *proto.GetACLResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetACLRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_GetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACL'
+type NameServer_GetACL_Call struct {
+	*mock.Call
+}
+
+// GetACL is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetACLRequest
+func (_e *NameServer_Expecter) GetACL(_a0 interface{}, _a1 interface{}) *NameServer_GetACL_Call {
+	return &NameServer_GetACL_Call{Call: _e.mock.On("GetACL", _a0, _a1)}
+}
+
+func (_c *NameServer_GetACL_Call) Run(run func(_a0 context.Context, _a1 *proto.GetACLRequest)) *NameServer_GetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetACLRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_GetACL_Call) Return(_a0 *proto.GetACLResponse, _a1 error) *NameServer_GetACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_GetACL_Call) RunAndReturn(run func(context.Context, *proto.GetACLRequest) (*proto.GetACLResponse, error)) *NameServer_GetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockAccessToken provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) GetBlockAccessToken(_a0 context.Context, _a1 *proto.GetBlockAccessTokenRequest) (*proto.GetBlockAccessTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockAccessToken")
+	}
+
+	var r0 *proto.GetBlockAccessTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockAccessTokenRequest) (*proto.GetBlockAccessTokenResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockAccessTokenRequest) *proto.GetBlockAccessTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetBlockAccessTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetBlockAccessTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_GetBlockAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockAccessToken'
+type NameServer_GetBlockAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetBlockAccessToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetBlockAccessTokenRequest
+func (_e *NameServer_Expecter) GetBlockAccessToken(_a0 interface{}, _a1 interface{}) *NameServer_GetBlockAccessToken_Call {
+	return &NameServer_GetBlockAccessToken_Call{Call: _e.mock.On("GetBlockAccessToken", _a0, _a1)}
+}
+
+func (_c *NameServer_GetBlockAccessToken_Call) Run(run func(_a0 context.Context, _a1 *proto.GetBlockAccessTokenRequest)) *NameServer_GetBlockAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetBlockAccessTokenRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_GetBlockAccessToken_Call) Return(_a0 *proto.GetBlockAccessTokenResponse, _a1 error) *NameServer_GetBlockAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_GetBlockAccessToken_Call) RunAndReturn(run func(context.Context, *proto.GetBlockAccessTokenRequest) (*proto.GetBlockAccessTokenResponse, error)) *NameServer_GetBlockAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentSummary provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) GetContentSummary(_a0 context.Context, _a1 *proto.GetContentSummaryRequest) (*proto.GetContentSummaryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentSummary")
+	}
+
+	var r0 *proto.GetContentSummaryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetContentSummaryRequest) (*proto.GetContentSummaryResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetContentSummaryRequest) *proto.GetContentSummaryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetContentSummaryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetContentSummaryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_GetContentSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentSummary'
+type NameServer_GetContentSummary_Call struct {
+	*mock.Call
+}
+
+// GetContentSummary is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetContentSummaryRequest
+func (_e *NameServer_Expecter) GetContentSummary(_a0 interface{}, _a1 interface{}) *NameServer_GetContentSummary_Call {
+	return &NameServer_GetContentSummary_Call{Call: _e.mock.On("GetContentSummary", _a0, _a1)}
+}
+
+func (_c *NameServer_GetContentSummary_Call) Run(run func(_a0 context.Context, _a1 *proto.GetContentSummaryRequest)) *NameServer_GetContentSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetContentSummaryRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_GetContentSummary_Call) Return(_a0 *proto.GetContentSummaryResponse, _a1 error) *NameServer_GetContentSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_GetContentSummary_Call) RunAndReturn(run func(context.Context, *proto.GetContentSummaryRequest) (*proto.GetContentSummaryResponse, error)) *NameServer_GetContentSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserQuota provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) GetUserQuota(_a0 context.Context, _a1 *proto.GetUserQuotaRequest) (*proto.GetUserQuotaResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserQuota")
+	}
+
+	var r0 *proto.GetUserQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetUserQuotaRequest) (*proto.GetUserQuotaResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetUserQuotaRequest) *proto.GetUserQuotaResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetUserQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetUserQuotaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_GetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserQuota'
+type NameServer_GetUserQuota_Call struct {
+	*mock.Call
+}
+
+// GetUserQuota is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetUserQuotaRequest
+func (_e *NameServer_Expecter) GetUserQuota(_a0 interface{}, _a1 interface{}) *NameServer_GetUserQuota_Call {
+	return &NameServer_GetUserQuota_Call{Call: _e.mock.On("GetUserQuota", _a0, _a1)}
+}
+
+func (_c *NameServer_GetUserQuota_Call) Run(run func(_a0 context.Context, _a1 *proto.GetUserQuotaRequest)) *NameServer_GetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetUserQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_GetUserQuota_Call) Return(_a0 *proto.GetUserQuotaResponse, _a1 error) *NameServer_GetUserQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_GetUserQuota_Call) RunAndReturn(run func(context.Context, *proto.GetUserQuotaRequest) (*proto.GetUserQuotaResponse, error)) *NameServer_GetUserQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *NameServer) List(_a0 context.Context, _a1 *proto.ListRequest) (*proto.ListResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -317,6 +612,65 @@ func (_c *NameServer_List_Call) RunAndReturn(run func(context.Context, *proto.Li
 	return _c
 }
 
+// ListSessions provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) ListSessions(_a0 context.Context, _a1 *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *proto.ListSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListSessionsRequest) *proto.ListSessionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.ListSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListSessionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type NameServer_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.ListSessionsRequest
+func (_e *NameServer_Expecter) ListSessions(_a0 interface{}, _a1 interface{}) *NameServer_ListSessions_Call {
+	return &NameServer_ListSessions_Call{Call: _e.mock.On("ListSessions", _a0, _a1)}
+}
+
+func (_c *NameServer_ListSessions_Call) Run(run func(_a0 context.Context, _a1 *proto.ListSessionsRequest)) *NameServer_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.ListSessionsRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_ListSessions_Call) Return(_a0 *proto.ListSessionsResponse, _a1 error) *NameServer_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_ListSessions_Call) RunAndReturn(run func(context.Context, *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error)) *NameServer_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: _a0, _a1
 func (_m *NameServer) Login(_a0 context.Context, _a1 *proto.LoginRequest) (*proto.LoginResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -435,6 +789,537 @@ func (_c *NameServer_Logout_Call) RunAndReturn(run func(context.Context, *proto.
 	return _c
 }
 
+// RemoveACL provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) RemoveACL(_a0 context.Context, _a1 *proto.RemoveACLRequest) (*proto.RemoveACLResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveACL")
+	}
+
+	var r0 *proto.RemoveACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RemoveACLRequest) (*proto.RemoveACLResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RemoveACLRequest) *proto.RemoveACLResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RemoveACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RemoveACLRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_RemoveACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveACL'
+type NameServer_RemoveACL_Call struct {
+	*mock.Call
+}
+
+// RemoveACL is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.RemoveACLRequest
+func (_e *NameServer_Expecter) RemoveACL(_a0 interface{}, _a1 interface{}) *NameServer_RemoveACL_Call {
+	return &NameServer_RemoveACL_Call{Call: _e.mock.On("RemoveACL", _a0, _a1)}
+}
+
+func (_c *NameServer_RemoveACL_Call) Run(run func(_a0 context.Context, _a1 *proto.RemoveACLRequest)) *NameServer_RemoveACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.RemoveACLRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_RemoveACL_Call) Return(_a0 *proto.RemoveACLResponse, _a1 error) *NameServer_RemoveACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_RemoveACL_Call) RunAndReturn(run func(context.Context, *proto.RemoveACLRequest) (*proto.RemoveACLResponse, error)) *NameServer_RemoveACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewDelegationToken provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) RenewDelegationToken(_a0 context.Context, _a1 *proto.RenewDelegationTokenRequest) (*proto.RenewDelegationTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewDelegationToken")
+	}
+
+	var r0 *proto.RenewDelegationTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RenewDelegationTokenRequest) (*proto.RenewDelegationTokenResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RenewDelegationTokenRequest) *proto.RenewDelegationTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RenewDelegationTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RenewDelegationTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_RenewDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewDelegationToken'
+type NameServer_RenewDelegationToken_Call struct {
+	*mock.Call
+}
+
+// RenewDelegationToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.RenewDelegationTokenRequest
+func (_e *NameServer_Expecter) RenewDelegationToken(_a0 interface{}, _a1 interface{}) *NameServer_RenewDelegationToken_Call {
+	return &NameServer_RenewDelegationToken_Call{Call: _e.mock.On("RenewDelegationToken", _a0, _a1)}
+}
+
+func (_c *NameServer_RenewDelegationToken_Call) Run(run func(_a0 context.Context, _a1 *proto.RenewDelegationTokenRequest)) *NameServer_RenewDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.RenewDelegationTokenRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_RenewDelegationToken_Call) Return(_a0 *proto.RenewDelegationTokenResponse, _a1 error) *NameServer_RenewDelegationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_RenewDelegationToken_Call) RunAndReturn(run func(context.Context, *proto.RenewDelegationTokenRequest) (*proto.RenewDelegationTokenResponse, error)) *NameServer_RenewDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) RevokeAllSessions(_a0 context.Context, _a1 *proto.RevokeAllSessionsRequest) (*proto.RevokeAllSessionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 *proto.RevokeAllSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeAllSessionsRequest) (*proto.RevokeAllSessionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeAllSessionsRequest) *proto.RevokeAllSessionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RevokeAllSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RevokeAllSessionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type NameServer_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.RevokeAllSessionsRequest
+func (_e *NameServer_Expecter) RevokeAllSessions(_a0 interface{}, _a1 interface{}) *NameServer_RevokeAllSessions_Call {
+	return &NameServer_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", _a0, _a1)}
+}
+
+func (_c *NameServer_RevokeAllSessions_Call) Run(run func(_a0 context.Context, _a1 *proto.RevokeAllSessionsRequest)) *NameServer_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.RevokeAllSessionsRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_RevokeAllSessions_Call) Return(_a0 *proto.RevokeAllSessionsResponse, _a1 error) *NameServer_RevokeAllSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_RevokeAllSessions_Call) RunAndReturn(run func(context.Context, *proto.RevokeAllSessionsRequest) (*proto.RevokeAllSessionsResponse, error)) *NameServer_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) RevokeSession(_a0 context.Context, _a1 *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *proto.RevokeSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevokeSessionRequest) *proto.RevokeSessionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.RevokeSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RevokeSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type NameServer_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.RevokeSessionRequest
+func (_e *NameServer_Expecter) RevokeSession(_a0 interface{}, _a1 interface{}) *NameServer_RevokeSession_Call {
+	return &NameServer_RevokeSession_Call{Call: _e.mock.On("RevokeSession", _a0, _a1)}
+}
+
+func (_c *NameServer_RevokeSession_Call) Run(run func(_a0 context.Context, _a1 *proto.RevokeSessionRequest)) *NameServer_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.RevokeSessionRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_RevokeSession_Call) Return(_a0 *proto.RevokeSessionResponse, _a1 error) *NameServer_RevokeSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_RevokeSession_Call) RunAndReturn(run func(context.Context, *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error)) *NameServer_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetACL provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) SetACL(_a0 context.Context, _a1 *proto.SetACLRequest) (*proto.SetACLResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetACL")
+	}
+
+	var r0 *proto.SetACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetACLRequest) (*proto.SetACLResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetACLRequest) *proto.SetACLResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetACLRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_SetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetACL'
+type NameServer_SetACL_Call struct {
+	*mock.Call
+}
+
+// SetACL is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetACLRequest
+func (_e *NameServer_Expecter) SetACL(_a0 interface{}, _a1 interface{}) *NameServer_SetACL_Call {
+	return &NameServer_SetACL_Call{Call: _e.mock.On("SetACL", _a0, _a1)}
+}
+
+func (_c *NameServer_SetACL_Call) Run(run func(_a0 context.Context, _a1 *proto.SetACLRequest)) *NameServer_SetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetACLRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_SetACL_Call) Return(_a0 *proto.SetACLResponse, _a1 error) *NameServer_SetACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_SetACL_Call) RunAndReturn(run func(context.Context, *proto.SetACLRequest) (*proto.SetACLResponse, error)) *NameServer_SetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOwner provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) SetOwner(_a0 context.Context, _a1 *proto.SetOwnerRequest) (*proto.SetOwnerResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOwner")
+	}
+
+	var r0 *proto.SetOwnerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetOwnerRequest) (*proto.SetOwnerResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetOwnerRequest) *proto.SetOwnerResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetOwnerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetOwnerRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_SetOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOwner'
+type NameServer_SetOwner_Call struct {
+	*mock.Call
+}
+
+// SetOwner is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetOwnerRequest
+func (_e *NameServer_Expecter) SetOwner(_a0 interface{}, _a1 interface{}) *NameServer_SetOwner_Call {
+	return &NameServer_SetOwner_Call{Call: _e.mock.On("SetOwner", _a0, _a1)}
+}
+
+func (_c *NameServer_SetOwner_Call) Run(run func(_a0 context.Context, _a1 *proto.SetOwnerRequest)) *NameServer_SetOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetOwnerRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_SetOwner_Call) Return(_a0 *proto.SetOwnerResponse, _a1 error) *NameServer_SetOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_SetOwner_Call) RunAndReturn(run func(context.Context, *proto.SetOwnerRequest) (*proto.SetOwnerResponse, error)) *NameServer_SetOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPermission provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) SetPermission(_a0 context.Context, _a1 *proto.SetPermissionRequest) (*proto.SetPermissionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPermission")
+	}
+
+	var r0 *proto.SetPermissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetPermissionRequest) (*proto.SetPermissionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetPermissionRequest) *proto.SetPermissionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetPermissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetPermissionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_SetPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPermission'
+type NameServer_SetPermission_Call struct {
+	*mock.Call
+}
+
+// SetPermission is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetPermissionRequest
+func (_e *NameServer_Expecter) SetPermission(_a0 interface{}, _a1 interface{}) *NameServer_SetPermission_Call {
+	return &NameServer_SetPermission_Call{Call: _e.mock.On("SetPermission", _a0, _a1)}
+}
+
+func (_c *NameServer_SetPermission_Call) Run(run func(_a0 context.Context, _a1 *proto.SetPermissionRequest)) *NameServer_SetPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetPermissionRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_SetPermission_Call) Return(_a0 *proto.SetPermissionResponse, _a1 error) *NameServer_SetPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_SetPermission_Call) RunAndReturn(run func(context.Context, *proto.SetPermissionRequest) (*proto.SetPermissionResponse, error)) *NameServer_SetPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQuota provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) SetQuota(_a0 context.Context, _a1 *proto.SetQuotaRequest) (*proto.SetQuotaResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQuota")
+	}
+
+	var r0 *proto.SetQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetQuotaRequest) (*proto.SetQuotaResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetQuotaRequest) *proto.SetQuotaResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetQuotaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_SetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQuota'
+type NameServer_SetQuota_Call struct {
+	*mock.Call
+}
+
+// SetQuota is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetQuotaRequest
+func (_e *NameServer_Expecter) SetQuota(_a0 interface{}, _a1 interface{}) *NameServer_SetQuota_Call {
+	return &NameServer_SetQuota_Call{Call: _e.mock.On("SetQuota", _a0, _a1)}
+}
+
+func (_c *NameServer_SetQuota_Call) Run(run func(_a0 context.Context, _a1 *proto.SetQuotaRequest)) *NameServer_SetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_SetQuota_Call) Return(_a0 *proto.SetQuotaResponse, _a1 error) *NameServer_SetQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_SetQuota_Call) RunAndReturn(run func(context.Context, *proto.SetQuotaRequest) (*proto.SetQuotaResponse, error)) *NameServer_SetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserQuota provides a mock function with given fields: _a0, _a1
+func (_m *NameServer) SetUserQuota(_a0 context.Context, _a1 *proto.SetUserQuotaRequest) (*proto.SetUserQuotaResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserQuota")
+	}
+
+	var r0 *proto.SetUserQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetUserQuotaRequest) (*proto.SetUserQuotaResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetUserQuotaRequest) *proto.SetUserQuotaResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetUserQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetUserQuotaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NameServer_SetUserQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserQuota'
+type NameServer_SetUserQuota_Call struct {
+	*mock.Call
+}
+
+// SetUserQuota is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetUserQuotaRequest
+func (_e *NameServer_Expecter) SetUserQuota(_a0 interface{}, _a1 interface{}) *NameServer_SetUserQuota_Call {
+	return &NameServer_SetUserQuota_Call{Call: _e.mock.On("SetUserQuota", _a0, _a1)}
+}
+
+func (_c *NameServer_SetUserQuota_Call) Run(run func(_a0 context.Context, _a1 *proto.SetUserQuotaRequest)) *NameServer_SetUserQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetUserQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *NameServer_SetUserQuota_Call) Return(_a0 *proto.SetUserQuotaResponse, _a1 error) *NameServer_SetUserQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NameServer_SetUserQuota_Call) RunAndReturn(run func(context.Context, *proto.SetUserQuotaRequest) (*proto.SetUserQuotaResponse, error)) *NameServer_SetUserQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stat provides a mock function with given fields: _a0, _a1
 func (_m *NameServer) Stat(_a0 context.Context, _a1 *proto.StatRequest) (*proto.StatResponse, error) {
 	ret := _m.Called(_a0, _a1)
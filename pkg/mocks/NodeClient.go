@@ -395,6 +395,154 @@ func (_c *NodeClient_GetBlockInfos_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetReplicationRate provides a mock function with given fields: ctx, in, opts
+func (_m *NodeClient) GetReplicationRate(ctx context.Context, in *proto.GetReplicationRateRequest, opts ...grpc.CallOption) (*proto.GetReplicationRateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplicationRate")
+	}
+
+	var r0 *proto.GetReplicationRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetReplicationRateRequest, ...grpc.CallOption) (*proto.GetReplicationRateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetReplicationRateRequest, ...grpc.CallOption) *proto.GetReplicationRateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetReplicationRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetReplicationRateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NodeClient_GetReplicationRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicationRate'
+type NodeClient_GetReplicationRate_Call struct {
+	*mock.Call
+}
+
+// GetReplicationRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.GetReplicationRateRequest
+//   - opts ...grpc.CallOption
+func (_e *NodeClient_Expecter) GetReplicationRate(ctx interface{}, in interface{}, opts ...interface{}) *NodeClient_GetReplicationRate_Call {
+	return &NodeClient_GetReplicationRate_Call{Call: _e.mock.On("GetReplicationRate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NodeClient_GetReplicationRate_Call) Run(run func(ctx context.Context, in *proto.GetReplicationRateRequest, opts ...grpc.CallOption)) *NodeClient_GetReplicationRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.GetReplicationRateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NodeClient_GetReplicationRate_Call) Return(_a0 *proto.GetReplicationRateResponse, _a1 error) *NodeClient_GetReplicationRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NodeClient_GetReplicationRate_Call) RunAndReturn(run func(context.Context, *proto.GetReplicationRateRequest, ...grpc.CallOption) (*proto.GetReplicationRateResponse, error)) *NodeClient_GetReplicationRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReplicationRate provides a mock function with given fields: ctx, in, opts
+func (_m *NodeClient) SetReplicationRate(ctx context.Context, in *proto.SetReplicationRateRequest, opts ...grpc.CallOption) (*proto.SetReplicationRateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReplicationRate")
+	}
+
+	var r0 *proto.SetReplicationRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetReplicationRateRequest, ...grpc.CallOption) (*proto.SetReplicationRateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetReplicationRateRequest, ...grpc.CallOption) *proto.SetReplicationRateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetReplicationRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetReplicationRateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NodeClient_SetReplicationRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReplicationRate'
+type NodeClient_SetReplicationRate_Call struct {
+	*mock.Call
+}
+
+// SetReplicationRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.SetReplicationRateRequest
+//   - opts ...grpc.CallOption
+func (_e *NodeClient_Expecter) SetReplicationRate(ctx interface{}, in interface{}, opts ...interface{}) *NodeClient_SetReplicationRate_Call {
+	return &NodeClient_SetReplicationRate_Call{Call: _e.mock.On("SetReplicationRate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NodeClient_SetReplicationRate_Call) Run(run func(ctx context.Context, in *proto.SetReplicationRateRequest, opts ...grpc.CallOption)) *NodeClient_SetReplicationRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.SetReplicationRateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NodeClient_SetReplicationRate_Call) Return(_a0 *proto.SetReplicationRateResponse, _a1 error) *NodeClient_SetReplicationRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NodeClient_SetReplicationRate_Call) RunAndReturn(run func(context.Context, *proto.SetReplicationRateRequest, ...grpc.CallOption) (*proto.SetReplicationRateResponse, error)) *NodeClient_SetReplicationRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteBlock provides a mock function with given fields: ctx, in, opts
 func (_m *NodeClient) WriteBlock(ctx context.Context, in *proto.WriteBlockRequest, opts ...grpc.CallOption) (*proto.WriteBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -317,6 +317,124 @@ func (_c *NodeServer_GetBlockInfos_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetReplicationRate provides a mock function with given fields: _a0, _a1
+func (_m *NodeServer) GetReplicationRate(_a0 context.Context, _a1 *proto.GetReplicationRateRequest) (*proto.GetReplicationRateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplicationRate")
+	}
+
+	var r0 *proto.GetReplicationRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetReplicationRateRequest) (*proto.GetReplicationRateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetReplicationRateRequest) *proto.GetReplicationRateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetReplicationRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetReplicationRateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NodeServer_GetReplicationRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicationRate'
+type NodeServer_GetReplicationRate_Call struct {
+	*mock.Call
+}
+
+// GetReplicationRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetReplicationRateRequest
+func (_e *NodeServer_Expecter) GetReplicationRate(_a0 interface{}, _a1 interface{}) *NodeServer_GetReplicationRate_Call {
+	return &NodeServer_GetReplicationRate_Call{Call: _e.mock.On("GetReplicationRate", _a0, _a1)}
+}
+
+func (_c *NodeServer_GetReplicationRate_Call) Run(run func(_a0 context.Context, _a1 *proto.GetReplicationRateRequest)) *NodeServer_GetReplicationRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetReplicationRateRequest))
+	})
+	return _c
+}
+
+func (_c *NodeServer_GetReplicationRate_Call) Return(_a0 *proto.GetReplicationRateResponse, _a1 error) *NodeServer_GetReplicationRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NodeServer_GetReplicationRate_Call) RunAndReturn(run func(context.Context, *proto.GetReplicationRateRequest) (*proto.GetReplicationRateResponse, error)) *NodeServer_GetReplicationRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReplicationRate provides a mock function with given fields: _a0, _a1
+func (_m *NodeServer) SetReplicationRate(_a0 context.Context, _a1 *proto.SetReplicationRateRequest) (*proto.SetReplicationRateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReplicationRate")
+	}
+
+	var r0 *proto.SetReplicationRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetReplicationRateRequest) (*proto.SetReplicationRateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.SetReplicationRateRequest) *proto.SetReplicationRateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.SetReplicationRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.SetReplicationRateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NodeServer_SetReplicationRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReplicationRate'
+type NodeServer_SetReplicationRate_Call struct {
+	*mock.Call
+}
+
+// SetReplicationRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.SetReplicationRateRequest
+func (_e *NodeServer_Expecter) SetReplicationRate(_a0 interface{}, _a1 interface{}) *NodeServer_SetReplicationRate_Call {
+	return &NodeServer_SetReplicationRate_Call{Call: _e.mock.On("SetReplicationRate", _a0, _a1)}
+}
+
+func (_c *NodeServer_SetReplicationRate_Call) Run(run func(_a0 context.Context, _a1 *proto.SetReplicationRateRequest)) *NodeServer_SetReplicationRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.SetReplicationRateRequest))
+	})
+	return _c
+}
+
+func (_c *NodeServer_SetReplicationRate_Call) Return(_a0 *proto.SetReplicationRateResponse, _a1 error) *NodeServer_SetReplicationRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NodeServer_SetReplicationRate_Call) RunAndReturn(run func(context.Context, *proto.SetReplicationRateRequest) (*proto.SetReplicationRateResponse, error)) *NodeServer_SetReplicationRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteBlock provides a mock function with given fields: _a0, _a1
 func (_m *NodeServer) WriteBlock(_a0 context.Context, _a1 *proto.WriteBlockRequest) (*proto.WriteBlockResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -25,6 +25,80 @@ func (_m *NotificationClient) EXPECT() *NotificationClient_Expecter {
 	return &NotificationClient_Expecter{mock: &_m.Mock}
 }
 
+// GetBlockKeys provides a mock function with given fields: ctx, in, opts
+func (_m *NotificationClient) GetBlockKeys(ctx context.Context, in *proto.GetBlockKeysRequest, opts ...grpc.CallOption) (*proto.GetBlockKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockKeys")
+	}
+
+	var r0 *proto.GetBlockKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockKeysRequest, ...grpc.CallOption) (*proto.GetBlockKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockKeysRequest, ...grpc.CallOption) *proto.GetBlockKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetBlockKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetBlockKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationClient_GetBlockKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockKeys'
+type NotificationClient_GetBlockKeys_Call struct {
+	*mock.Call
+}
+
+// GetBlockKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.GetBlockKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *NotificationClient_Expecter) GetBlockKeys(ctx interface{}, in interface{}, opts ...interface{}) *NotificationClient_GetBlockKeys_Call {
+	return &NotificationClient_GetBlockKeys_Call{Call: _e.mock.On("GetBlockKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NotificationClient_GetBlockKeys_Call) Run(run func(ctx context.Context, in *proto.GetBlockKeysRequest, opts ...grpc.CallOption)) *NotificationClient_GetBlockKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.GetBlockKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotificationClient_GetBlockKeys_Call) Return(_a0 *proto.GetBlockKeysResponse, _a1 error) *NotificationClient_GetBlockKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationClient_GetBlockKeys_Call) RunAndReturn(run func(context.Context, *proto.GetBlockKeysRequest, ...grpc.CallOption) (*proto.GetBlockKeysResponse, error)) *NotificationClient_GetBlockKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyBlockAdded provides a mock function with given fields: ctx, in, opts
 func (_m *NotificationClient) NotifyBlockAdded(ctx context.Context, in *proto.NotifyBlockAddedRequest, opts ...grpc.CallOption) (*proto.NotifyBlockAddedResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -247,6 +321,80 @@ func (_c *NotificationClient_NotifyBlockRemoved_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// NotifyNodeStatus provides a mock function with given fields: ctx, in, opts
+func (_m *NotificationClient) NotifyNodeStatus(ctx context.Context, in *proto.NotifyNodeStatusRequest, opts ...grpc.CallOption) (*proto.NotifyNodeStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyNodeStatus")
+	}
+
+	var r0 *proto.NotifyNodeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.NotifyNodeStatusRequest, ...grpc.CallOption) (*proto.NotifyNodeStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.NotifyNodeStatusRequest, ...grpc.CallOption) *proto.NotifyNodeStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.NotifyNodeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.NotifyNodeStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationClient_NotifyNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyNodeStatus'
+type NotificationClient_NotifyNodeStatus_Call struct {
+	*mock.Call
+}
+
+// NotifyNodeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proto.NotifyNodeStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *NotificationClient_Expecter) NotifyNodeStatus(ctx interface{}, in interface{}, opts ...interface{}) *NotificationClient_NotifyNodeStatus_Call {
+	return &NotificationClient_NotifyNodeStatus_Call{Call: _e.mock.On("NotifyNodeStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NotificationClient_NotifyNodeStatus_Call) Run(run func(ctx context.Context, in *proto.NotifyNodeStatusRequest, opts ...grpc.CallOption)) *NotificationClient_NotifyNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proto.NotifyNodeStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotificationClient_NotifyNodeStatus_Call) Return(_a0 *proto.NotifyNodeStatusResponse, _a1 error) *NotificationClient_NotifyNodeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationClient_NotifyNodeStatus_Call) RunAndReturn(run func(context.Context, *proto.NotifyNodeStatusRequest, ...grpc.CallOption) (*proto.NotifyNodeStatusResponse, error)) *NotificationClient_NotifyNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNotificationClient creates a new instance of NotificationClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNotificationClient(t interface {
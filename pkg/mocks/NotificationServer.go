@@ -22,6 +22,65 @@ func (_m *NotificationServer) EXPECT() *NotificationServer_Expecter {
 	return &NotificationServer_Expecter{mock: &_m.Mock}
 }
 
+// GetBlockKeys provides a mock function with given fields: _a0, _a1
+func (_m *NotificationServer) GetBlockKeys(_a0 context.Context, _a1 *proto.GetBlockKeysRequest) (*proto.GetBlockKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockKeys")
+	}
+
+	var r0 *proto.GetBlockKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockKeysRequest) (*proto.GetBlockKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GetBlockKeysRequest) *proto.GetBlockKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GetBlockKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GetBlockKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationServer_GetBlockKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockKeys'
+type NotificationServer_GetBlockKeys_Call struct {
+	*mock.Call
+}
+
+// GetBlockKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.GetBlockKeysRequest
+func (_e *NotificationServer_Expecter) GetBlockKeys(_a0 interface{}, _a1 interface{}) *NotificationServer_GetBlockKeys_Call {
+	return &NotificationServer_GetBlockKeys_Call{Call: _e.mock.On("GetBlockKeys", _a0, _a1)}
+}
+
+func (_c *NotificationServer_GetBlockKeys_Call) Run(run func(_a0 context.Context, _a1 *proto.GetBlockKeysRequest)) *NotificationServer_GetBlockKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GetBlockKeysRequest))
+	})
+	return _c
+}
+
+func (_c *NotificationServer_GetBlockKeys_Call) Return(_a0 *proto.GetBlockKeysResponse, _a1 error) *NotificationServer_GetBlockKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationServer_GetBlockKeys_Call) RunAndReturn(run func(context.Context, *proto.GetBlockKeysRequest) (*proto.GetBlockKeysResponse, error)) *NotificationServer_GetBlockKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyBlockAdded provides a mock function with given fields: _a0, _a1
 func (_m *NotificationServer) NotifyBlockAdded(_a0 context.Context, _a1 *proto.NotifyBlockAddedRequest) (*proto.NotifyBlockAddedResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -199,6 +258,65 @@ func (_c *NotificationServer_NotifyBlockRemoved_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// NotifyNodeStatus provides a mock function with given fields: _a0, _a1
+func (_m *NotificationServer) NotifyNodeStatus(_a0 context.Context, _a1 *proto.NotifyNodeStatusRequest) (*proto.NotifyNodeStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyNodeStatus")
+	}
+
+	var r0 *proto.NotifyNodeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.NotifyNodeStatusRequest) (*proto.NotifyNodeStatusResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.NotifyNodeStatusRequest) *proto.NotifyNodeStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.NotifyNodeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.NotifyNodeStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationServer_NotifyNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyNodeStatus'
+type NotificationServer_NotifyNodeStatus_Call struct {
+	*mock.Call
+}
+
+// NotifyNodeStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proto.NotifyNodeStatusRequest
+func (_e *NotificationServer_Expecter) NotifyNodeStatus(_a0 interface{}, _a1 interface{}) *NotificationServer_NotifyNodeStatus_Call {
+	return &NotificationServer_NotifyNodeStatus_Call{Call: _e.mock.On("NotifyNodeStatus", _a0, _a1)}
+}
+
+func (_c *NotificationServer_NotifyNodeStatus_Call) Run(run func(_a0 context.Context, _a1 *proto.NotifyNodeStatusRequest)) *NotificationServer_NotifyNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.NotifyNodeStatusRequest))
+	})
+	return _c
+}
+
+func (_c *NotificationServer_NotifyNodeStatus_Call) Return(_a0 *proto.NotifyNodeStatusResponse, _a1 error) *NotificationServer_NotifyNodeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationServer_NotifyNodeStatus_Call) RunAndReturn(run func(context.Context, *proto.NotifyNodeStatusRequest) (*proto.NotifyNodeStatusResponse, error)) *NotificationServer_NotifyNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // mustEmbedUnimplementedNotificationServer provides a mock function with no fields
 func (_m *NotificationServer) mustEmbedUnimplementedNotificationServer() {
 	_m.Called()
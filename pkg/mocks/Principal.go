@@ -79,6 +79,190 @@ func (_c *Principal_ComputePrivileges_Call) RunAndReturn(run func(...name.HasPer
 	return _c
 }
 
+// Groups provides a mock function with no fields
+func (_m *Principal) Groups() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Groups")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Principal_Groups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Groups'
+type Principal_Groups_Call struct {
+	*mock.Call
+}
+
+// Groups is a helper method to define mock.On call
+func (_e *Principal_Expecter) Groups() *Principal_Groups_Call {
+	return &Principal_Groups_Call{Call: _e.mock.On("Groups")}
+}
+
+func (_c *Principal_Groups_Call) Run(run func()) *Principal_Groups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Principal_Groups_Call) Return(_a0 []string) *Principal_Groups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Principal_Groups_Call) RunAndReturn(run func() []string) *Principal_Groups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scope provides a mock function with no fields
+func (_m *Principal) Scope() *name.TokenScope {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scope")
+	}
+
+	var r0 *name.TokenScope
+	if rf, ok := ret.Get(0).(func() *name.TokenScope); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*name.TokenScope)
+		}
+	}
+
+	return r0
+}
+
+// Principal_Scope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scope'
+type Principal_Scope_Call struct {
+	*mock.Call
+}
+
+// Scope is a helper method to define mock.On call
+func (_e *Principal_Expecter) Scope() *Principal_Scope_Call {
+	return &Principal_Scope_Call{Call: _e.mock.On("Scope")}
+}
+
+func (_c *Principal_Scope_Call) Run(run func()) *Principal_Scope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Principal_Scope_Call) Return(_a0 *name.TokenScope) *Principal_Scope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Principal_Scope_Call) RunAndReturn(run func() *name.TokenScope) *Principal_Scope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Umask provides a mock function with no fields
+func (_m *Principal) Umask() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Umask")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Principal_Umask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Umask'
+type Principal_Umask_Call struct {
+	*mock.Call
+}
+
+// Umask is a helper method to define mock.On call
+func (_e *Principal_Expecter) Umask() *Principal_Umask_Call {
+	return &Principal_Umask_Call{Call: _e.mock.On("Umask")}
+}
+
+func (_c *Principal_Umask_Call) Run(run func()) *Principal_Umask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Principal_Umask_Call) Return(_a0 string) *Principal_Umask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Principal_Umask_Call) RunAndReturn(run func() string) *Principal_Umask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// User provides a mock function with no fields
+func (_m *Principal) User() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Principal_User_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'User'
+type Principal_User_Call struct {
+	*mock.Call
+}
+
+// User is a helper method to define mock.On call
+func (_e *Principal_Expecter) User() *Principal_User_Call {
+	return &Principal_User_Call{Call: _e.mock.On("User")}
+}
+
+func (_c *Principal_User_Call) Run(run func()) *Principal_User_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Principal_User_Call) Return(_a0 string) *Principal_User_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Principal_User_Call) RunAndReturn(run func() string) *Principal_User_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPrincipal creates a new instance of Principal. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPrincipal(t interface {
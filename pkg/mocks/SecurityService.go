@@ -5,6 +5,8 @@ package mocks
 import (
 	name "github.com/cirglo.com/dfs/pkg/name"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // SecurityService is an autogenerated mock type for the SecurityService type
@@ -171,6 +173,70 @@ func (_c *SecurityService_ChangeUserPassword_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// CreateAPIKey provides a mock function with given fields: userName, expiresAt
+func (_m *SecurityService) CreateAPIKey(userName string, expiresAt *time.Time) (string, name.APIKey, error) {
+	ret := _m.Called(userName, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 string
+	var r1 name.APIKey
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, *time.Time) (string, name.APIKey, error)); ok {
+		return rf(userName, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time) string); ok {
+		r0 = rf(userName, expiresAt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time) name.APIKey); ok {
+		r1 = rf(userName, expiresAt)
+	} else {
+		r1 = ret.Get(1).(name.APIKey)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, *time.Time) error); ok {
+		r2 = rf(userName, expiresAt)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SecurityService_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type SecurityService_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - userName string
+//   - expiresAt *time.Time
+func (_e *SecurityService_Expecter) CreateAPIKey(userName interface{}, expiresAt interface{}) *SecurityService_CreateAPIKey_Call {
+	return &SecurityService_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", userName, expiresAt)}
+}
+
+func (_c *SecurityService_CreateAPIKey_Call) Run(run func(userName string, expiresAt *time.Time)) *SecurityService_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *SecurityService_CreateAPIKey_Call) Return(_a0 string, _a1 name.APIKey, _a2 error) *SecurityService_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SecurityService_CreateAPIKey_Call) RunAndReturn(run func(string, *time.Time) (string, name.APIKey, error)) *SecurityService_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateGroup provides a mock function with given fields: group
 func (_m *SecurityService) CreateGroup(group name.Group) error {
 	ret := _m.Called(group)
@@ -355,6 +421,110 @@ func (_c *SecurityService_DeleteUser_Call) RunAndReturn(run func(string) error)
 	return _c
 }
 
+// ExpirePassword provides a mock function with given fields: userName
+func (_m *SecurityService) ExpirePassword(userName string) error {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_ExpirePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePassword'
+type SecurityService_ExpirePassword_Call struct {
+	*mock.Call
+}
+
+// ExpirePassword is a helper method to define mock.On call
+//   - userName string
+func (_e *SecurityService_Expecter) ExpirePassword(userName interface{}) *SecurityService_ExpirePassword_Call {
+	return &SecurityService_ExpirePassword_Call{Call: _e.mock.On("ExpirePassword", userName)}
+}
+
+func (_c *SecurityService_ExpirePassword_Call) Run(run func(userName string)) *SecurityService_ExpirePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_ExpirePassword_Call) Return(_a0 error) *SecurityService_ExpirePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_ExpirePassword_Call) RunAndReturn(run func(string) error) *SecurityService_ExpirePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeys provides a mock function with given fields: userName
+func (_m *SecurityService) GetAPIKeys(userName string) ([]name.APIKey, error) {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeys")
+	}
+
+	var r0 []name.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]name.APIKey, error)); ok {
+		return rf(userName)
+	}
+	if rf, ok := ret.Get(0).(func(string) []name.APIKey); ok {
+		r0 = rf(userName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]name.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_GetAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeys'
+type SecurityService_GetAPIKeys_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeys is a helper method to define mock.On call
+//   - userName string
+func (_e *SecurityService_Expecter) GetAPIKeys(userName interface{}) *SecurityService_GetAPIKeys_Call {
+	return &SecurityService_GetAPIKeys_Call{Call: _e.mock.On("GetAPIKeys", userName)}
+}
+
+func (_c *SecurityService_GetAPIKeys_Call) Run(run func(userName string)) *SecurityService_GetAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_GetAPIKeys_Call) Return(_a0 []name.APIKey, _a1 error) *SecurityService_GetAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_GetAPIKeys_Call) RunAndReturn(run func(string) ([]name.APIKey, error)) *SecurityService_GetAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllGroups provides a mock function with no fields
 func (_m *SecurityService) GetAllGroups() ([]name.Group, error) {
 	ret := _m.Called()
@@ -525,6 +695,64 @@ func (_c *SecurityService_GetGroup_Call) RunAndReturn(run func(string) (name.Gro
 	return _c
 }
 
+// GetSessions provides a mock function with given fields: userName
+func (_m *SecurityService) GetSessions(userName string) ([]name.Token, error) {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 []name.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]name.Token, error)); ok {
+		return rf(userName)
+	}
+	if rf, ok := ret.Get(0).(func(string) []name.Token); ok {
+		r0 = rf(userName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]name.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
+type SecurityService_GetSessions_Call struct {
+	*mock.Call
+}
+
+// GetSessions is a helper method to define mock.On call
+//   - userName string
+func (_e *SecurityService_Expecter) GetSessions(userName interface{}) *SecurityService_GetSessions_Call {
+	return &SecurityService_GetSessions_Call{Call: _e.mock.On("GetSessions", userName)}
+}
+
+func (_c *SecurityService_GetSessions_Call) Run(run func(userName string)) *SecurityService_GetSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_GetSessions_Call) Return(_a0 []name.Token, _a1 error) *SecurityService_GetSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_GetSessions_Call) RunAndReturn(run func(string) ([]name.Token, error)) *SecurityService_GetSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function with given fields: userName
 func (_m *SecurityService) GetUser(userName string) (name.User, error) {
 	ret := _m.Called(userName)
@@ -581,6 +809,128 @@ func (_c *SecurityService_GetUser_Call) RunAndReturn(run func(string) (name.User
 	return _c
 }
 
+// IssueDelegationToken provides a mock function with given fields: userName, scope, lifetime, renewer
+func (_m *SecurityService) IssueDelegationToken(userName string, scope name.TokenScope, lifetime time.Duration, renewer string) (string, time.Time, error) {
+	ret := _m.Called(userName, scope, lifetime, renewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueDelegationToken")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, name.TokenScope, time.Duration, string) (string, time.Time, error)); ok {
+		return rf(userName, scope, lifetime, renewer)
+	}
+	if rf, ok := ret.Get(0).(func(string, name.TokenScope, time.Duration, string) string); ok {
+		r0 = rf(userName, scope, lifetime, renewer)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, name.TokenScope, time.Duration, string) time.Time); ok {
+		r1 = rf(userName, scope, lifetime, renewer)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, name.TokenScope, time.Duration, string) error); ok {
+		r2 = rf(userName, scope, lifetime, renewer)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SecurityService_IssueDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueDelegationToken'
+type SecurityService_IssueDelegationToken_Call struct {
+	*mock.Call
+}
+
+// IssueDelegationToken is a helper method to define mock.On call
+//   - userName string
+//   - scope name.TokenScope
+//   - lifetime time.Duration
+//   - renewer string
+func (_e *SecurityService_Expecter) IssueDelegationToken(userName interface{}, scope interface{}, lifetime interface{}, renewer interface{}) *SecurityService_IssueDelegationToken_Call {
+	return &SecurityService_IssueDelegationToken_Call{Call: _e.mock.On("IssueDelegationToken", userName, scope, lifetime, renewer)}
+}
+
+func (_c *SecurityService_IssueDelegationToken_Call) Run(run func(userName string, scope name.TokenScope, lifetime time.Duration, renewer string)) *SecurityService_IssueDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(name.TokenScope), args[2].(time.Duration), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_IssueDelegationToken_Call) Return(_a0 string, _a1 time.Time, _a2 error) *SecurityService_IssueDelegationToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SecurityService_IssueDelegationToken_Call) RunAndReturn(run func(string, name.TokenScope, time.Duration, string) (string, time.Time, error)) *SecurityService_IssueDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueToken provides a mock function with given fields: user
+func (_m *SecurityService) IssueToken(user name.User) (string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(name.User) (string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(name.User) string); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(name.User) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_IssueToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueToken'
+type SecurityService_IssueToken_Call struct {
+	*mock.Call
+}
+
+// IssueToken is a helper method to define mock.On call
+//   - user name.User
+func (_e *SecurityService_Expecter) IssueToken(user interface{}) *SecurityService_IssueToken_Call {
+	return &SecurityService_IssueToken_Call{Call: _e.mock.On("IssueToken", user)}
+}
+
+func (_c *SecurityService_IssueToken_Call) Run(run func(user name.User)) *SecurityService_IssueToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(name.User))
+	})
+	return _c
+}
+
+func (_c *SecurityService_IssueToken_Call) Return(_a0 string, _a1 error) *SecurityService_IssueToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_IssueToken_Call) RunAndReturn(run func(name.User) (string, error)) *SecurityService_IssueToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logout provides a mock function with given fields: token
 func (_m *SecurityService) Logout(token string) error {
 	ret := _m.Called(token)
@@ -683,25 +1033,80 @@ func (_c *SecurityService_LookupUserByToken_Call) RunAndReturn(run func(string)
 	return _c
 }
 
-// RemoveUserFromGroup provides a mock function with given fields: userName, groupName
-func (_m *SecurityService) RemoveUserFromGroup(userName string, groupName string) error {
-	ret := _m.Called(userName, groupName)
+// PurgeExpiredTokens provides a mock function with no fields
+func (_m *SecurityService) PurgeExpiredTokens() (int64, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveUserFromGroup")
+		panic("no return value specified for PurgeExpiredTokens")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(userName, groupName)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// SecurityService_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
+// SecurityService_PurgeExpiredTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredTokens'
+type SecurityService_PurgeExpiredTokens_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredTokens is a helper method to define mock.On call
+func (_e *SecurityService_Expecter) PurgeExpiredTokens() *SecurityService_PurgeExpiredTokens_Call {
+	return &SecurityService_PurgeExpiredTokens_Call{Call: _e.mock.On("PurgeExpiredTokens")}
+}
+
+func (_c *SecurityService_PurgeExpiredTokens_Call) Run(run func()) *SecurityService_PurgeExpiredTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SecurityService_PurgeExpiredTokens_Call) Return(_a0 int64, _a1 error) *SecurityService_PurgeExpiredTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_PurgeExpiredTokens_Call) RunAndReturn(run func() (int64, error)) *SecurityService_PurgeExpiredTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserFromGroup provides a mock function with given fields: userName, groupName
+func (_m *SecurityService) RemoveUserFromGroup(userName string, groupName string) error {
+	ret := _m.Called(userName, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserFromGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userName, groupName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
 type SecurityService_RemoveUserFromGroup_Call struct {
 	*mock.Call
 }
@@ -730,6 +1135,410 @@ func (_c *SecurityService_RemoveUserFromGroup_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// RenewDelegationToken provides a mock function with given fields: renewer, token
+func (_m *SecurityService) RenewDelegationToken(renewer string, token string) (time.Time, error) {
+	ret := _m.Called(renewer, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewDelegationToken")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (time.Time, error)); ok {
+		return rf(renewer, token)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) time.Time); ok {
+		r0 = rf(renewer, token)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(renewer, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_RenewDelegationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewDelegationToken'
+type SecurityService_RenewDelegationToken_Call struct {
+	*mock.Call
+}
+
+// RenewDelegationToken is a helper method to define mock.On call
+//   - renewer string
+//   - token string
+func (_e *SecurityService_Expecter) RenewDelegationToken(renewer interface{}, token interface{}) *SecurityService_RenewDelegationToken_Call {
+	return &SecurityService_RenewDelegationToken_Call{Call: _e.mock.On("RenewDelegationToken", renewer, token)}
+}
+
+func (_c *SecurityService_RenewDelegationToken_Call) Run(run func(renewer string, token string)) *SecurityService_RenewDelegationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_RenewDelegationToken_Call) Return(_a0 time.Time, _a1 error) *SecurityService_RenewDelegationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_RenewDelegationToken_Call) RunAndReturn(run func(string, string) (time.Time, error)) *SecurityService_RenewDelegationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: userName, id
+func (_m *SecurityService) RevokeAPIKey(userName string, id uint64) error {
+	ret := _m.Called(userName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64) error); ok {
+		r0 = rf(userName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type SecurityService_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - userName string
+//   - id uint64
+func (_e *SecurityService_Expecter) RevokeAPIKey(userName interface{}, id interface{}) *SecurityService_RevokeAPIKey_Call {
+	return &SecurityService_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", userName, id)}
+}
+
+func (_c *SecurityService_RevokeAPIKey_Call) Run(run func(userName string, id uint64)) *SecurityService_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *SecurityService_RevokeAPIKey_Call) Return(_a0 error) *SecurityService_RevokeAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_RevokeAPIKey_Call) RunAndReturn(run func(string, uint64) error) *SecurityService_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function with given fields: userName
+func (_m *SecurityService) RevokeAllSessions(userName string) error {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type SecurityService_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - userName string
+func (_e *SecurityService_Expecter) RevokeAllSessions(userName interface{}) *SecurityService_RevokeAllSessions_Call {
+	return &SecurityService_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", userName)}
+}
+
+func (_c *SecurityService_RevokeAllSessions_Call) Run(run func(userName string)) *SecurityService_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_RevokeAllSessions_Call) Return(_a0 error) *SecurityService_RevokeAllSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_RevokeAllSessions_Call) RunAndReturn(run func(string) error) *SecurityService_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: userName, id
+func (_m *SecurityService) RevokeSession(userName string, id uint64) error {
+	ret := _m.Called(userName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64) error); ok {
+		r0 = rf(userName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type SecurityService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - userName string
+//   - id uint64
+func (_e *SecurityService_Expecter) RevokeSession(userName interface{}, id interface{}) *SecurityService_RevokeSession_Call {
+	return &SecurityService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", userName, id)}
+}
+
+func (_c *SecurityService_RevokeSession_Call) Run(run func(userName string, id uint64)) *SecurityService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *SecurityService_RevokeSession_Call) Return(_a0 error) *SecurityService_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_RevokeSession_Call) RunAndReturn(run func(string, uint64) error) *SecurityService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIKey provides a mock function with given fields: userName, id
+func (_m *SecurityService) RotateAPIKey(userName string, id uint64) (string, error) {
+	ret := _m.Called(userName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIKey")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint64) (string, error)); ok {
+		return rf(userName, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64) string); ok {
+		r0 = rf(userName, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(userName, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type SecurityService_RotateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RotateAPIKey is a helper method to define mock.On call
+//   - userName string
+//   - id uint64
+func (_e *SecurityService_Expecter) RotateAPIKey(userName interface{}, id interface{}) *SecurityService_RotateAPIKey_Call {
+	return &SecurityService_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey", userName, id)}
+}
+
+func (_c *SecurityService_RotateAPIKey_Call) Run(run func(userName string, id uint64)) *SecurityService_RotateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *SecurityService_RotateAPIKey_Call) Return(_a0 string, _a1 error) *SecurityService_RotateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_RotateAPIKey_Call) RunAndReturn(run func(string, uint64) (string, error)) *SecurityService_RotateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserUmask provides a mock function with given fields: userName, umask
+func (_m *SecurityService) SetUserUmask(userName string, umask string) error {
+	ret := _m.Called(userName, umask)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserUmask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userName, umask)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_SetUserUmask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserUmask'
+type SecurityService_SetUserUmask_Call struct {
+	*mock.Call
+}
+
+// SetUserUmask is a helper method to define mock.On call
+//   - userName string
+//   - umask string
+func (_e *SecurityService_Expecter) SetUserUmask(userName interface{}, umask interface{}) *SecurityService_SetUserUmask_Call {
+	return &SecurityService_SetUserUmask_Call{Call: _e.mock.On("SetUserUmask", userName, umask)}
+}
+
+func (_c *SecurityService_SetUserUmask_Call) Run(run func(userName string, umask string)) *SecurityService_SetUserUmask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_SetUserUmask_Call) Return(_a0 error) *SecurityService_SetUserUmask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_SetUserUmask_Call) RunAndReturn(run func(string, string) error) *SecurityService_SetUserUmask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockUser provides a mock function with given fields: userName
+func (_m *SecurityService) UnlockUser(userName string) error {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityService_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type SecurityService_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - userName string
+func (_e *SecurityService_Expecter) UnlockUser(userName interface{}) *SecurityService_UnlockUser_Call {
+	return &SecurityService_UnlockUser_Call{Call: _e.mock.On("UnlockUser", userName)}
+}
+
+func (_c *SecurityService_UnlockUser_Call) Run(run func(userName string)) *SecurityService_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_UnlockUser_Call) Return(_a0 error) *SecurityService_UnlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityService_UnlockUser_Call) RunAndReturn(run func(string) error) *SecurityService_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyPassword provides a mock function with given fields: userName, password
+func (_m *SecurityService) VerifyPassword(userName string, password string) (name.User, error) {
+	ret := _m.Called(userName, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyPassword")
+	}
+
+	var r0 name.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (name.User, error)); ok {
+		return rf(userName, password)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) name.User); ok {
+		r0 = rf(userName, password)
+	} else {
+		r0 = ret.Get(0).(name.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userName, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityService_VerifyPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyPassword'
+type SecurityService_VerifyPassword_Call struct {
+	*mock.Call
+}
+
+// VerifyPassword is a helper method to define mock.On call
+//   - userName string
+//   - password string
+func (_e *SecurityService_Expecter) VerifyPassword(userName interface{}, password interface{}) *SecurityService_VerifyPassword_Call {
+	return &SecurityService_VerifyPassword_Call{Call: _e.mock.On("VerifyPassword", userName, password)}
+}
+
+func (_c *SecurityService_VerifyPassword_Call) Run(run func(userName string, password string)) *SecurityService_VerifyPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SecurityService_VerifyPassword_Call) Return(_a0 name.User, _a1 error) *SecurityService_VerifyPassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityService_VerifyPassword_Call) RunAndReturn(run func(string, string) (name.User, error)) *SecurityService_VerifyPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSecurityService creates a new instance of SecurityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSecurityService(t interface {
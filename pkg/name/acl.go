@@ -0,0 +1,272 @@
+package name
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ACLTypeUser  = "user"
+	ACLTypeGroup = "group"
+	ACLTypeMask  = "mask"
+)
+
+// ACLEntry grants a named user or group a permission on a file. The mask
+// entry limits the permissions granted by named entries and by the group of
+// the file. Default entries of directories are copied to new children.
+type ACLEntry struct {
+	ID         uint64     `gorm:"autoIncrement;primaryKey"`
+	FileInfoID uint64     `gorm:"uniqueIndex:idx_aclentry;not null"`
+	Default    bool       `gorm:"uniqueIndex:idx_aclentry;not null"`
+	Type       string     `gorm:"uniqueIndex:idx_aclentry;not null"`
+	Name       string     `gorm:"uniqueIndex:idx_aclentry;not null"`
+	Permission Permission `gorm:"embedded;embeddedPrefix:permission_"`
+}
+
+// HasACL is implemented by things with an access control list in addition to
+// their permissions.
+type HasACL interface {
+	GetACL() []ACLEntry
+}
+
+func (e *ACLEntry) BeforeSave(_ *gorm.DB) error {
+	e.Name = strings.TrimSpace(e.Name)
+
+	return e.Validate()
+}
+
+func (e *ACLEntry) Validate() error {
+	switch e.Type {
+	case ACLTypeUser, ACLTypeGroup:
+		if e.Name == "" {
+			return fmt.Errorf("%s entry must have a name", e.Type)
+		}
+	case ACLTypeMask:
+		if e.Name != "" {
+			return fmt.Errorf("mask entry cannot have a name")
+		}
+	default:
+		return fmt.Errorf("invalid acl entry type '%s'", e.Type)
+	}
+
+	return nil
+}
+
+func (e *ACLEntry) matches(o ACLEntry) bool {
+	return e.Default == o.Default && e.Type == o.Type && e.Name == o.Name
+}
+
+func unionPermission(p Permission, o Permission) Permission {
+	return Permission{
+		Read:    p.Read || o.Read,
+		Write:   p.Write || o.Write,
+		Delete:  p.Delete || o.Delete,
+		Execute: p.Execute || o.Execute,
+	}
+}
+
+func intersectPermission(p Permission, o Permission) Permission {
+	return Permission{
+		Read:    p.Read && o.Read,
+		Write:   p.Write && o.Write,
+		Delete:  p.Delete && o.Delete,
+		Execute: p.Execute && o.Execute,
+	}
+}
+
+// recalculateMask sets the mask of the access or the default entries to the
+// union of the permissions it limits, as setfacl does. The mask is removed
+// when there are no named entries left.
+func recalculateMask(entries []ACLEntry, groupPermission Permission, isDefault bool) []ACLEntry {
+	var result []ACLEntry
+	mask := groupPermission
+	named := false
+
+	for _, entry := range entries {
+		if entry.Default != isDefault {
+			result = append(result, entry)
+			continue
+		}
+		if entry.Type == ACLTypeMask {
+			continue
+		}
+		named = true
+		mask = unionPermission(mask, entry.Permission)
+		result = append(result, entry)
+	}
+
+	if named {
+		result = append(result, ACLEntry{Default: isDefault, Type: ACLTypeMask, Permission: mask})
+	}
+
+	return result
+}
+
+// inheritACL returns the entries a new child of parent starts with. Files and
+// directories get the default entries of parent as access entries,
+// directories also keep them as their own default entries.
+func inheritACL(parent FileInfo, isDir bool) []ACLEntry {
+	var entries []ACLEntry
+
+	for _, entry := range parent.ACL {
+		if !entry.Default {
+			continue
+		}
+
+		entries = append(entries, ACLEntry{Type: entry.Type, Name: entry.Name, Permission: entry.Permission})
+		if isDir {
+			entries = append(entries, ACLEntry{Default: true, Type: entry.Type, Name: entry.Name, Permission: entry.Permission})
+		}
+	}
+
+	return entries
+}
+
+// GetACL returns the access entries followed by the default entries of path.
+func (f *fileService) GetACL(p Principal, path string) ([]ACLEntry, error) {
+	fileInfo, err := f.Stat(p, path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := fileInfo.ACL
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Default != entries[j].Default {
+			return !entries[i].Default
+		}
+		if entries[i].Type != entries[j].Type {
+			return entries[i].Type < entries[j].Type
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// updateACL replaces the entries of path by the result of update. Only the
+// owner of the file or a superuser may change its entries, delegated tokens
+// only with write access in their scope.
+func (f *fileService) updateACL(p Principal, path string, update func(fileInfo FileInfo) ([]ACLEntry, error)) error {
+	return f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		if !f.canTraverse(p, fileInfos[:len(fileInfos)-1]) || !scopeAllows(p, chainPath(fileInfos), Privileges{Write: true}) {
+			return fmt.Errorf("permission denied for %s", path)
+		}
+
+		fileInfo := fileInfos[len(fileInfos)-1]
+		if !IsSuperuser(p, f.Opts.SuperuserGroup) && fileInfo.Permissions.Owner != p.User() {
+			return fmt.Errorf("permission denied, %s is not the owner of %s", p.User(), path)
+		}
+
+		entries, err := update(fileInfo)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("file_info_id = ?", fileInfo.ID).Delete(&ACLEntry{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete acl entries: %w", err)
+		}
+
+		for i := range entries {
+			entries[i].ID = 0
+			entries[i].FileInfoID = fileInfo.ID
+		}
+
+		if len(entries) > 0 {
+			err = tx.Create(&entries).Error
+			if err != nil {
+				return fmt.Errorf("failed to create acl entries: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// SetACL adds the entries to the ACL of path or replaces the permission of
+// existing ones. The masks are recalculated unless entries contain them.
+func (f *fileService) SetACL(p Principal, path string, entries []ACLEntry) error {
+	err := f.updateACL(p, path, func(fileInfo FileInfo) ([]ACLEntry, error) {
+		result := fileInfo.ACL
+		explicitMask := map[bool]bool{}
+
+		for _, entry := range entries {
+			err := entry.Validate()
+			if err != nil {
+				return nil, err
+			}
+			if entry.Default && !fileInfo.IsDir {
+				return nil, fmt.Errorf("only directories can have default entries")
+			}
+			if entry.Type == ACLTypeMask {
+				explicitMask[entry.Default] = true
+			}
+
+			replaced := false
+			for i := range result {
+				if result[i].matches(entry) {
+					result[i].Permission = entry.Permission
+					replaced = true
+				}
+			}
+			if !replaced {
+				result = append(result, entry)
+			}
+		}
+
+		for _, isDefault := range []bool{false, true} {
+			if !explicitMask[isDefault] {
+				result = recalculateMask(result, fileInfo.Permissions.GroupPermission, isDefault)
+			}
+		}
+
+		return result, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set acl of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// RemoveACL removes the entries from the ACL of path, or all entries when none
+// are given.
+func (f *fileService) RemoveACL(p Principal, path string, entries []ACLEntry) error {
+	err := f.updateACL(p, path, func(fileInfo FileInfo) ([]ACLEntry, error) {
+		if len(entries) == 0 {
+			return nil, nil
+		}
+
+		var result []ACLEntry
+		for _, existing := range fileInfo.ACL {
+			removed := false
+			for _, entry := range entries {
+				if existing.matches(entry) {
+					removed = true
+				}
+			}
+			if !removed {
+				result = append(result, existing)
+			}
+		}
+
+		for _, isDefault := range []bool{false, true} {
+			result = recalculateMask(result, fileInfo.Permissions.GroupPermission, isDefault)
+		}
+
+		return result, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove acl of %s: %w", path, err)
+	}
+
+	return nil
+}
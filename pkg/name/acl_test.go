@@ -0,0 +1,150 @@
+package name_test
+
+import (
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHasACL struct {
+	mockHasPermissions
+	acl []name.ACLEntry
+}
+
+func (m mockHasACL) GetACL() []name.ACLEntry {
+	return m.acl
+}
+
+func TestPrincipal_ComputePrivilegesACL(t *testing.T) {
+	readWrite := name.Permission{Read: true, Write: true}
+	fileInfo := mockHasACL{
+		mockHasPermissions: mockHasPermissions{permissions: name.Permissions{
+			Owner:           "alice",
+			Group:           "staff",
+			OwnerPermission: name.Permission{Read: true, Write: true, Delete: true},
+			GroupPermission: readWrite,
+		}},
+		acl: []name.ACLEntry{
+			{Type: name.ACLTypeUser, Name: "bob", Permission: readWrite},
+			{Type: name.ACLTypeGroup, Name: "dev", Permission: name.Permission{Read: true, Delete: true}},
+			{Type: name.ACLTypeUser, Name: "carol", Permission: readWrite, Default: true},
+			{Type: name.ACLTypeMask, Permission: name.Permission{Read: true, Delete: true}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		user     name.User
+		expected name.Privileges
+	}{
+		{
+			name:     "Owner is not masked",
+			user:     name.User{Name: "alice"},
+			expected: name.Privileges{Read: true, Write: true, Delete: true},
+		},
+		{
+			name:     "Named user is masked",
+			user:     name.User{Name: "bob"},
+			expected: name.Privileges{Read: true},
+		},
+		{
+			name:     "Named group",
+			user:     name.User{Name: "dave", Groups: []*name.Group{{Name: "dev"}}},
+			expected: name.Privileges{Read: true, Delete: true},
+		},
+		{
+			name:     "Owning group is masked",
+			user:     name.User{Name: "erin", Groups: []*name.Group{{Name: "staff"}}},
+			expected: name.Privileges{Read: true},
+		},
+		{
+			name:     "Default entries do not grant access",
+			user:     name.User{Name: "carol"},
+			expected: name.Privileges{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			privileges := name.NewPrincipal(test.user).ComputePrivileges(fileInfo)
+			assert.Equal(t, test.expected, privileges)
+		})
+	}
+}
+
+func TestFileService_ACL(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "dev"}}})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+	read := name.Permission{Read: true}
+
+	_, err = service.CreateDir(alice, "/project", &name.Permissions{OwnerPermission: all, GroupPermission: read})
+	assert.NoError(t, err)
+
+	_, err = service.CreateFile(bob, "/project/bob.txt", &name.Permissions{})
+	assert.Error(t, err)
+
+	err = service.SetACL(bob, "/project", []name.ACLEntry{{Type: name.ACLTypeUser, Name: "bob", Permission: all}})
+	assert.Error(t, err)
+
+	err = service.SetACL(alice, "/project", []name.ACLEntry{
+		{Type: name.ACLTypeGroup, Name: "dev", Permission: all},
+		{Type: name.ACLTypeGroup, Name: "dev", Permission: read, Default: true},
+	})
+	assert.NoError(t, err)
+
+	entries, err := service.GetACL(alice, "/project")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	assert.Equal(t, name.ACLTypeMask, entries[1].Type)
+	assert.Equal(t, all, entries[1].Permission)
+	assert.True(t, entries[3].Default)
+	assert.Equal(t, read, entries[3].Permission)
+
+	_, err = service.CreateFile(bob, "/project/bob.txt", &name.Permissions{})
+	assert.NoError(t, err)
+
+	_, err = service.CreateDir(alice, "/project/docs", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+
+	entries, err = service.GetACL(alice, "/project/docs")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+
+	fileInfo, err := service.Stat(alice, "/project/docs")
+	assert.NoError(t, err)
+	assert.Equal(t, name.Privileges{Read: true}, bob.ComputePrivileges(&fileInfo))
+
+	err = service.SetACL(alice, "/project", []name.ACLEntry{{Type: name.ACLTypeMask, Permission: read}})
+	assert.NoError(t, err)
+
+	_, err = service.CreateFile(bob, "/project/other.txt", &name.Permissions{})
+	assert.Error(t, err)
+
+	err = service.SetACL(alice, "/project/bob.txt", []name.ACLEntry{{Type: name.ACLTypeUser, Name: "bob", Permission: all, Default: true}})
+	assert.Error(t, err)
+
+	err = service.SetACL(alice, "/project", []name.ACLEntry{{Type: "other", Permission: all}})
+	assert.Error(t, err)
+
+	err = service.RemoveACL(alice, "/project", []name.ACLEntry{{Type: name.ACLTypeGroup, Name: "dev"}})
+	assert.NoError(t, err)
+
+	entries, err = service.GetACL(alice, "/project")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	err = service.RemoveACL(alice, "/project", nil)
+	assert.NoError(t, err)
+
+	entries, err = service.GetACL(alice, "/project")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
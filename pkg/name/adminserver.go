@@ -0,0 +1,528 @@
+package name
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultSuperuserGroup = "supergroup"
+
+type AdminServerOpts struct {
+	Logger          *logrus.Logger
+	SecurityService SecurityService
+	SuperuserGroup  string
+	// ClusterSecret is used to issue node keys. Node keys can not be issued
+	// when it is empty.
+	ClusterSecret []byte
+	// AuditLog answers queries of audit events. Audit events can not be
+	// listed when it is nil.
+	AuditLog AuditLog
+	// BlockKeys signs the node access tokens. Node access tokens can not be
+	// issued when it is nil.
+	BlockKeys            *blocktoken.Keyring
+	BlockTokenExpiration time.Duration
+}
+
+func (o *AdminServerOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.SecurityService == nil {
+		return fmt.Errorf("security service is required")
+	}
+	if o.SuperuserGroup == "" {
+		return fmt.Errorf("superuser group is required")
+	}
+
+	return nil
+}
+
+// AdminServer manages users and groups. Every call requires the token of a
+// member of the superuser group.
+type AdminServer struct {
+	proto.UnimplementedAdminServer
+	Opts AdminServerOpts
+}
+
+var _ proto.AdminServer = AdminServer{}
+
+func NewAdminServer(opts AdminServerOpts) (proto.AdminServer, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	return AdminServer{Opts: opts}, nil
+}
+
+func (s AdminServer) authorize(ctx context.Context, token string, action string) error {
+	principal, err := resolvePrincipal(ctx, s.Opts.Logger, s.Opts.SecurityService, token)
+	if err != nil {
+		return err
+	}
+
+	if principal.Scope() != nil {
+		return status.Error(codes.PermissionDenied, "delegated tokens may not administer users and groups")
+	}
+
+	if !IsSuperuser(principal, s.Opts.SuperuserGroup) {
+		s.Opts.Logger.WithFields(logrus.Fields{
+			"user":   principal.User(),
+			"action": action,
+		}).Warn("Denied admin request")
+		return status.Errorf(codes.PermissionDenied, "user %s is not a member of group %s", principal.User(), s.Opts.SuperuserGroup)
+	}
+
+	s.Opts.Logger.WithFields(logrus.Fields{
+		"user":   principal.User(),
+		"action": action,
+	}).Info("Admin request")
+
+	return nil
+}
+
+func (s AdminServer) CreateUser(ctx context.Context, request *proto.CreateUserRequest) (*proto.CreateUserResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "create-user")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+
+	if request.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	err = s.Opts.SecurityService.CreateUser(User{Name: request.GetUser(), Password: request.GetPassword()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return &proto.CreateUserResponse{}, nil
+}
+
+func (s AdminServer) DeleteUser(ctx context.Context, request *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "delete-user")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.DeleteUser(request.GetUser())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return &proto.DeleteUserResponse{}, nil
+}
+
+func (s AdminServer) ListUsers(ctx context.Context, request *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "list-users")
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.Opts.SecurityService.GetAllUsers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	var userInfos []*proto.UserInfo
+
+	for _, user := range users {
+		userInfo := &proto.UserInfo{
+			Name:               user.Name,
+			Umask:              user.Umask,
+			ServiceAccount:     user.ServiceAccount,
+			LockedUntil:        unixOrZero(user.LockedUntil),
+			MustChangePassword: user.MustChangePassword,
+		}
+		for _, group := range user.Groups {
+			userInfo.Groups = append(userInfo.Groups, group.Name)
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+
+	return &proto.ListUsersResponse{Users: userInfos}, nil
+}
+
+func (s AdminServer) ChangeUserPassword(ctx context.Context, request *proto.ChangeUserPasswordRequest) (*proto.ChangeUserPasswordResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "change-user-password")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	err = s.Opts.SecurityService.ChangeUserPassword(request.GetUser(), request.GetPassword())
+	if err != nil {
+		return nil, fmt.Errorf("failed to change password: %w", err)
+	}
+
+	return &proto.ChangeUserPasswordResponse{}, nil
+}
+
+func (s AdminServer) SetUserUmask(ctx context.Context, request *proto.SetUserUmaskRequest) (*proto.SetUserUmaskResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "set-user-umask")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.SetUserUmask(request.GetUser(), request.GetUmask())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set umask: %w", err)
+	}
+
+	return &proto.SetUserUmaskResponse{}, nil
+}
+
+func (s AdminServer) CreateGroup(ctx context.Context, request *proto.CreateGroupRequest) (*proto.CreateGroupResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "create-group")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetGroup() == "" {
+		return nil, status.Error(codes.InvalidArgument, "group is required")
+	}
+
+	err = s.Opts.SecurityService.CreateGroup(Group{Name: request.GetGroup()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	return &proto.CreateGroupResponse{}, nil
+}
+
+func (s AdminServer) DeleteGroup(ctx context.Context, request *proto.DeleteGroupRequest) (*proto.DeleteGroupResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "delete-group")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetGroup() == s.Opts.SuperuserGroup {
+		return nil, status.Errorf(codes.FailedPrecondition, "group %s can not be deleted", s.Opts.SuperuserGroup)
+	}
+
+	err = s.Opts.SecurityService.DeleteGroup(request.GetGroup())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	return &proto.DeleteGroupResponse{}, nil
+}
+
+func (s AdminServer) ListGroups(ctx context.Context, request *proto.ListGroupsRequest) (*proto.ListGroupsResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "list-groups")
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.Opts.SecurityService.GetAllGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	var groupInfos []*proto.GroupInfo
+
+	for _, group := range groups {
+		groupInfo := &proto.GroupInfo{Name: group.Name}
+		for _, user := range group.Users {
+			groupInfo.Users = append(groupInfo.Users, user.Name)
+		}
+		groupInfos = append(groupInfos, groupInfo)
+	}
+
+	return &proto.ListGroupsResponse{Groups: groupInfos}, nil
+}
+
+func (s AdminServer) AddUserToGroup(ctx context.Context, request *proto.AddUserToGroupRequest) (*proto.AddUserToGroupResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "add-user-to-group")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.AddUserToGroup(request.GetUser(), request.GetGroup())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add user to group: %w", err)
+	}
+
+	return &proto.AddUserToGroupResponse{}, nil
+}
+
+func (s AdminServer) RemoveUserFromGroup(ctx context.Context, request *proto.RemoveUserFromGroupRequest) (*proto.RemoveUserFromGroupResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "remove-user-from-group")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.RemoveUserFromGroup(request.GetUser(), request.GetGroup())
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove user from group: %w", err)
+	}
+
+	return &proto.RemoveUserFromGroupResponse{}, nil
+}
+
+func (s AdminServer) IssueNodeKey(ctx context.Context, request *proto.IssueNodeKeyRequest) (*proto.IssueNodeKeyResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "issue-node-key")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetHost() == "" {
+		return nil, status.Error(codes.InvalidArgument, "host is required")
+	}
+
+	if len(s.Opts.ClusterSecret) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no cluster secret is configured")
+	}
+
+	key := proto.DeriveNodeKey(s.Opts.ClusterSecret, request.GetHost())
+
+	return &proto.IssueNodeKeyResponse{Key: hex.EncodeToString(key)}, nil
+}
+
+// IssueNodeAccessToken issues a token for the calls that concern a whole
+// node, such as listing its blocks.
+func (s AdminServer) IssueNodeAccessToken(ctx context.Context, request *proto.IssueNodeAccessTokenRequest) (*proto.IssueNodeAccessTokenResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "issue-node-access-token")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetHost() == "" {
+		return nil, status.Error(codes.InvalidArgument, "host is required")
+	}
+
+	if s.Opts.BlockKeys == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no block keys are configured")
+	}
+
+	expiration := s.Opts.BlockTokenExpiration
+	if expiration == 0 {
+		expiration = DefaultBlockTokenExpiration
+	}
+
+	token, err := s.Opts.BlockKeys.Issue(request.GetHost(), "", blocktoken.OpAdmin, 0, expiration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue node access token: %v", err)
+	}
+
+	return &proto.IssueNodeAccessTokenResponse{AccessToken: token}, nil
+}
+
+func (s AdminServer) ListAuditEvents(ctx context.Context, request *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "list-audit-events")
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Opts.AuditLog == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no audit log is configured")
+	}
+
+	events, err := s.Opts.AuditLog.Query(AuditQuery{
+		User:  request.GetUser(),
+		Path:  request.GetPath(),
+		Limit: int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query audit events: %v", err)
+	}
+
+	response := &proto.ListAuditEventsResponse{}
+	for _, event := range events {
+		response.Events = append(response.Events, &proto.AuditEvent{
+			Time:        event.Time.Unix(),
+			User:        event.User,
+			Proxy:       event.Proxy,
+			ClientIp:    event.ClientIP,
+			Operation:   event.Operation,
+			Path:        event.Path,
+			Destination: event.Destination,
+			TargetUser:  event.TargetUser,
+			TargetGroup: event.TargetGroup,
+			Result:      event.Result,
+			Error:       event.Error,
+		})
+	}
+
+	return response, nil
+}
+
+func (s AdminServer) CreateServiceAccount(ctx context.Context, request *proto.CreateServiceAccountRequest) (*proto.CreateServiceAccountResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "create-service-account")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+
+	err = s.Opts.SecurityService.CreateUser(User{Name: request.GetUser(), ServiceAccount: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	return &proto.CreateServiceAccountResponse{}, nil
+}
+
+func (s AdminServer) CreateAPIKey(ctx context.Context, request *proto.CreateAPIKeyRequest) (*proto.CreateAPIKeyResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "create-api-key")
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if request.GetExpiresAt() != 0 {
+		t := time.Unix(request.GetExpiresAt(), 0)
+		expiresAt = &t
+	}
+
+	key, apiKey, err := s.Opts.SecurityService.CreateAPIKey(request.GetUser(), expiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create api key: %v", err)
+	}
+
+	return &proto.CreateAPIKeyResponse{Id: apiKey.ID, Key: key}, nil
+}
+
+// unixOrZero returns the seconds since the epoch of t, 0 if t is nil.
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+func (s AdminServer) ListAPIKeys(ctx context.Context, request *proto.ListAPIKeysRequest) (*proto.ListAPIKeysResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "list-api-keys")
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.Opts.SecurityService.GetAPIKeys(request.GetUser())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to list api keys: %v", err)
+	}
+
+	response := &proto.ListAPIKeysResponse{}
+	for _, apiKey := range apiKeys {
+		response.Keys = append(response.Keys, &proto.APIKey{
+			Id:         apiKey.ID,
+			CreatedAt:  apiKey.CreatedAt.Unix(),
+			ExpiresAt:  unixOrZero(apiKey.ExpiresAt),
+			LastUsedAt: unixOrZero(apiKey.LastUsedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (s AdminServer) RotateAPIKey(ctx context.Context, request *proto.RotateAPIKeyRequest) (*proto.RotateAPIKeyResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "rotate-api-key")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.Opts.SecurityService.RotateAPIKey(request.GetUser(), request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to rotate api key %d: %v", request.GetId(), err)
+	}
+
+	return &proto.RotateAPIKeyResponse{Key: key}, nil
+}
+
+func (s AdminServer) RevokeAPIKey(ctx context.Context, request *proto.RevokeAPIKeyRequest) (*proto.RevokeAPIKeyResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "revoke-api-key")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.RevokeAPIKey(request.GetUser(), request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to revoke api key %d: %v", request.GetId(), err)
+	}
+
+	return &proto.RevokeAPIKeyResponse{}, nil
+}
+
+func (s AdminServer) UnlockUser(ctx context.Context, request *proto.UnlockUserRequest) (*proto.UnlockUserResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "unlock-user")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.UnlockUser(request.GetUser())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to unlock user %s: %v", request.GetUser(), err)
+	}
+
+	return &proto.UnlockUserResponse{}, nil
+}
+
+func (s AdminServer) ExpireUserPassword(ctx context.Context, request *proto.ExpireUserPasswordRequest) (*proto.ExpireUserPasswordResponse, error) {
+	err := s.authorize(ctx, request.GetToken(), "expire-user-password")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.ExpirePassword(request.GetUser())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to expire password of user %s: %v", request.GetUser(), err)
+	}
+
+	return &proto.ExpireUserPasswordResponse{}, nil
+}
+
+// BootstrapAdmin creates the first user of a fresh installation and makes it
+// a member of the superuser group. It does nothing once any user exists and
+// reports whether the user was created.
+func BootstrapAdmin(securityService SecurityService, userName string, password string, superuserGroup string) (bool, error) {
+	users, err := securityService.GetAllUsers()
+	if err != nil {
+		return false, fmt.Errorf("could not get users: %w", err)
+	}
+
+	if len(users) > 0 {
+		return false, nil
+	}
+
+	if password == "" {
+		return false, fmt.Errorf("password of bootstrap admin %s is required", userName)
+	}
+
+	_, err = securityService.GetGroup(superuserGroup)
+	if err != nil {
+		err = securityService.CreateGroup(Group{Name: superuserGroup})
+		if err != nil {
+			return false, fmt.Errorf("could not create group %s: %w", superuserGroup, err)
+		}
+	}
+
+	err = securityService.CreateUser(User{Name: userName, Password: password})
+	if err != nil {
+		return false, fmt.Errorf("could not create user %s: %w", userName, err)
+	}
+
+	err = securityService.AddUserToGroup(userName, superuserGroup)
+	if err != nil {
+		return false, fmt.Errorf("could not add user %s to group %s: %w", userName, superuserGroup, err)
+	}
+
+	return true, nil
+}
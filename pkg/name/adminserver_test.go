@@ -0,0 +1,104 @@
+package name_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createAdminServer(t *testing.T, securityService name.SecurityService) proto.AdminServer {
+	server, err := name.NewAdminServer(name.AdminServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		SuperuserGroup:  name.DefaultSuperuserGroup,
+	})
+	assert.NoError(t, err)
+
+	return server
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+
+	created, err := name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	user, err := securityService.GetUser("admin")
+	assert.NoError(t, err)
+	assert.Len(t, user.Groups, 1)
+	assert.Equal(t, name.DefaultSuperuserGroup, user.Groups[0].Name)
+
+	created, err = name.BootstrapAdmin(securityService, "other", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	_, err = securityService.GetUser("other")
+	assert.Error(t, err)
+}
+
+func TestAdminServer(t *testing.T) {
+	ctx := context.Background()
+	securityService := createSecurityService(t, createSecurityDB(t))
+	server := createAdminServer(t, securityService)
+
+	_, err := name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+
+	token, err := securityService.AuthenticateUser("admin", "secret")
+	assert.NoError(t, err)
+
+	_, err = server.CreateUser(ctx, &proto.CreateUserRequest{Token: token, User: "alice", Password: "alice-secret"})
+	assert.NoError(t, err)
+
+	_, err = server.CreateGroup(ctx, &proto.CreateGroupRequest{Token: token, Group: "staff"})
+	assert.NoError(t, err)
+
+	_, err = server.AddUserToGroup(ctx, &proto.AddUserToGroupRequest{Token: token, User: "alice", Group: "staff"})
+	assert.NoError(t, err)
+
+	users, err := server.ListUsers(ctx, &proto.ListUsersRequest{Token: token})
+	assert.NoError(t, err)
+	assert.Len(t, users.Users, 2)
+
+	groups, err := server.ListGroups(ctx, &proto.ListGroupsRequest{Token: token})
+	assert.NoError(t, err)
+	assert.Len(t, groups.Groups, 2)
+
+	_, err = server.ChangeUserPassword(ctx, &proto.ChangeUserPasswordRequest{Token: token, User: "alice", Password: "changed"})
+	assert.NoError(t, err)
+
+	aliceToken, err := securityService.AuthenticateUser("alice", "changed")
+	assert.NoError(t, err)
+
+	_, err = server.CreateUser(ctx, &proto.CreateUserRequest{Token: aliceToken, User: "mallory", Password: "secret"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.ListUsers(ctx, &proto.ListUsersRequest{Token: "invalid"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.DeleteGroup(ctx, &proto.DeleteGroupRequest{Token: token, Group: name.DefaultSuperuserGroup})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.RemoveUserFromGroup(ctx, &proto.RemoveUserFromGroupRequest{Token: token, User: "alice", Group: "staff"})
+	assert.NoError(t, err)
+
+	_, err = server.DeleteGroup(ctx, &proto.DeleteGroupRequest{Token: token, Group: "staff"})
+	assert.NoError(t, err)
+
+	_, err = server.DeleteUser(ctx, &proto.DeleteUserRequest{Token: token, User: "alice"})
+	assert.NoError(t, err)
+
+	_, err = server.DeleteUser(ctx, &proto.DeleteUserRequest{Token: token, User: "alice"})
+	assert.Error(t, err)
+
+	users, err = server.ListUsers(ctx, &proto.ListUsersRequest{Token: token})
+	assert.NoError(t, err)
+	assert.Len(t, users.Users, 1)
+}
@@ -0,0 +1,221 @@
+package name
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// APIKeyPrefix starts every API key, which tells them apart from the tokens
+// issued by a login.
+const APIKeyPrefix = "dfs_"
+
+// APIKey authenticates a service account without a login. API keys are sent
+// as bearer token like any other token.
+type APIKey struct {
+	ID        uint64    `gorm:"column:id;autoIncrement;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+	// Value is the hex encoded SHA-256 of the key handed out.
+	Value    string `gorm:"column:value;not null;uniqueIndex"`
+	UserName string `gorm:"column:user_name;not null;index"`
+	User     User   `gorm:"foreignKey:UserName;references:Name"`
+	// ExpiresAt is nil for keys that do not expire.
+	ExpiresAt *time.Time `gorm:"column:expired_at;index"`
+	// LastUsedAt is updated when the key is looked up, at most once per TTL
+	// of the token cache. It is nil for keys never used.
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+}
+
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && k.ExpiresAt.Before(time.Now())
+}
+
+// newAPIKey returns a random API key to hand to a service account.
+func newAPIKey() (string, error) {
+	keyBytes := make([]byte, tokenLength)
+	_, err := rand.Read(keyBytes)
+	if err != nil {
+		return "", fmt.Errorf("could not create api key: %w", err)
+	}
+
+	return APIKeyPrefix + hex.EncodeToString(keyBytes), nil
+}
+
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// serviceAccount returns the service account of the name.
+func serviceAccount(tx *gorm.DB, userName string) (User, error) {
+	user := User{}
+	err := tx.Where("name = ?", userName).First(&user).Error
+	if err != nil {
+		return User{}, fmt.Errorf("user %s not found: %w", userName, err)
+	}
+
+	if !user.ServiceAccount {
+		return User{}, fmt.Errorf("user %s is not a service account", userName)
+	}
+
+	return user, nil
+}
+
+// CreateAPIKey creates a key of a service account, which expires at
+// expiresAt unless it is nil.
+func (s *securityService) CreateAPIKey(userName string, expiresAt *time.Time) (string, APIKey, error) {
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return "", APIKey{}, fmt.Errorf("expiration %s is in the past", expiresAt.Format(time.RFC3339))
+	}
+
+	var key string
+	apiKey := APIKey{UserName: userName, ExpiresAt: expiresAt}
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := serviceAccount(tx, userName)
+		if err != nil {
+			return err
+		}
+
+		key, err = newAPIKey()
+		if err != nil {
+			return err
+		}
+		apiKey.Value = hashToken(key)
+
+		err = tx.Create(&apiKey).Error
+		if err != nil {
+			return fmt.Errorf("failed to create api key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", APIKey{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return key, apiKey, nil
+}
+
+// GetAPIKeys returns the keys of a service account, oldest first. The stored
+// values are hashes and can not be used to authenticate.
+func (s *securityService) GetAPIKeys(userName string) ([]APIKey, error) {
+	apiKeys := []APIKey{}
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := serviceAccount(tx, userName)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("user_name = ?", userName).Order("created_at, id").Find(&apiKeys).Error
+		if err != nil {
+			return fmt.Errorf("failed to get api keys of user %s: %w", userName, err)
+		}
+
+		return nil
+	}, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return apiKeys, nil
+}
+
+// RotateAPIKey replaces a key of a service account by a new one with the same
+// id and expiration. The old key stops working at once.
+func (s *securityService) RotateAPIKey(userName string, id uint64) (string, error) {
+	var key string
+	apiKey := APIKey{}
+	var oldValue string
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ? AND user_name = ?", id, userName).First(&apiKey).Error
+		if err != nil {
+			return fmt.Errorf("api key %d of user %s not found: %w", id, userName, err)
+		}
+		oldValue = apiKey.Value
+
+		key, err = newAPIKey()
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&apiKey).UpdateColumns(map[string]interface{}{
+			"value":        hashToken(key),
+			"last_used_at": nil,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to rotate api key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("transaction failed: %w", err)
+	}
+
+	s.cache.remove(oldValue)
+
+	return key, nil
+}
+
+func (s *securityService) RevokeAPIKey(userName string, id uint64) error {
+	apiKey := APIKey{}
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ? AND user_name = ?", id, userName).First(&apiKey).Error
+		if err != nil {
+			return fmt.Errorf("api key %d of user %s not found: %w", id, userName, err)
+		}
+
+		err = tx.Delete(&apiKey).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete api key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	s.cache.remove(apiKey.Value)
+
+	return nil
+}
+
+// lookupUserByAPIKey returns the service account of an API key and records
+// that the key was used.
+func (s *securityService) lookupUserByAPIKey(hash string) (User, time.Time, error) {
+	apiKey := APIKey{}
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("User.Groups").Where("value = ?", hash).First(&apiKey).Error
+		if err != nil {
+			return fmt.Errorf("could not get api key: %w", err)
+		}
+
+		if apiKey.IsExpired() {
+			return fmt.Errorf("api key is expired")
+		}
+
+		err = tx.Model(&apiKey).UpdateColumn("last_used_at", time.Now()).Error
+		if err != nil {
+			return fmt.Errorf("failed to update last use of api key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return User{}, time.Time{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	// keys without expiration are cached for the TTL
+	expiresAt := time.Now().Add(s.Opts.TokenCacheTTL)
+	if apiKey.ExpiresAt != nil {
+		expiresAt = *apiKey.ExpiresAt
+	}
+
+	return apiKey.User, expiresAt, nil
+}
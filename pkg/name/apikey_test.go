@@ -0,0 +1,124 @@
+package name_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityService_APIKeys(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	assert.Error(t, service.CreateUser(name.User{Name: "etl", Password: "secret", ServiceAccount: true}))
+	assert.NoError(t, service.CreateUser(name.User{Name: "etl", ServiceAccount: true}))
+	assert.NoError(t, service.CreateUser(name.User{Name: "alice", Password: "secret"}))
+	assert.NoError(t, service.CreateGroup(name.Group{Name: "loaders"}))
+	assert.NoError(t, service.AddUserToGroup("etl", "loaders"))
+
+	_, err := service.AuthenticateUser("etl", "")
+	assert.Error(t, err)
+	assert.Error(t, service.ChangeUserPassword("etl", "secret"))
+
+	_, _, err = service.CreateAPIKey("alice", nil)
+	assert.Error(t, err)
+	past := time.Now().Add(-time.Hour)
+	_, _, err = service.CreateAPIKey("etl", &past)
+	assert.Error(t, err)
+
+	key, apiKey, err := service.CreateAPIKey("etl", nil)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, name.APIKeyPrefix))
+
+	user, err := service.LookupUserByToken(key)
+	assert.NoError(t, err)
+	assert.Equal(t, "etl", user.Name)
+	assert.True(t, user.ServiceAccount)
+	assert.Equal(t, []string{"loaders"}, name.NewPrincipal(user).Groups())
+
+	apiKeys, err := service.GetAPIKeys("etl")
+	assert.NoError(t, err)
+	assert.Len(t, apiKeys, 1)
+	assert.Equal(t, apiKey.ID, apiKeys[0].ID)
+	assert.NotEqual(t, key, apiKeys[0].Value)
+	assert.Nil(t, apiKeys[0].ExpiresAt)
+	assert.NotNil(t, apiKeys[0].LastUsedAt)
+
+	rotated, err := service.RotateAPIKey("etl", apiKey.ID)
+	assert.NoError(t, err)
+	_, err = service.LookupUserByToken(key)
+	assert.Error(t, err)
+	_, err = service.LookupUserByToken(rotated)
+	assert.NoError(t, err)
+
+	assert.Error(t, service.RevokeAPIKey("alice", apiKey.ID))
+	assert.NoError(t, service.RevokeAPIKey("etl", apiKey.ID))
+	_, err = service.LookupUserByToken(rotated)
+	assert.Error(t, err)
+
+	future := time.Now().Add(time.Hour)
+	expiring, apiKey, err := service.CreateAPIKey("etl", &future)
+	assert.NoError(t, err)
+	err = db.Model(&name.APIKey{}).Where("id = ?", apiKey.ID).Update("expired_at", time.Now().Add(-time.Minute)).Error
+	assert.NoError(t, err)
+	_, err = service.LookupUserByToken(expiring)
+	assert.Error(t, err)
+
+	assert.NoError(t, service.DeleteUser("etl"))
+	_, err = service.GetAPIKeys("etl")
+	assert.Error(t, err)
+}
+
+func TestAdminServer_APIKeys(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	_, err := name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+	token, err := securityService.AuthenticateUser("admin", "secret")
+	assert.NoError(t, err)
+
+	server, err := name.NewAdminServer(name.AdminServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		SuperuserGroup:  name.DefaultSuperuserGroup,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = server.CreateServiceAccount(ctx, &proto.CreateServiceAccountRequest{Token: token, User: "etl"})
+	assert.NoError(t, err)
+
+	users, err := server.ListUsers(ctx, &proto.ListUsersRequest{Token: token})
+	assert.NoError(t, err)
+	for _, user := range users.GetUsers() {
+		assert.Equal(t, user.GetName() == "etl", user.GetServiceAccount())
+	}
+
+	created, err := server.CreateAPIKey(ctx, &proto.CreateAPIKeyRequest{Token: token, User: "etl"})
+	assert.NoError(t, err)
+
+	keys, err := server.ListAPIKeys(ctx, &proto.ListAPIKeysRequest{Token: token, User: "etl"})
+	assert.NoError(t, err)
+	assert.Len(t, keys.GetKeys(), 1)
+	assert.Equal(t, created.GetId(), keys.GetKeys()[0].GetId())
+	assert.Zero(t, keys.GetKeys()[0].GetExpiresAt())
+
+	// api keys are bearer tokens of the service account, but not of a superuser
+	_, err = server.ListUsers(ctx, &proto.ListUsersRequest{Token: created.GetKey()})
+	assert.Error(t, err)
+
+	rotated, err := server.RotateAPIKey(ctx, &proto.RotateAPIKeyRequest{Token: token, User: "etl", Id: created.GetId()})
+	assert.NoError(t, err)
+	assert.NotEqual(t, created.GetKey(), rotated.GetKey())
+
+	_, err = server.RevokeAPIKey(ctx, &proto.RevokeAPIKeyRequest{Token: token, User: "etl", Id: created.GetId()})
+	assert.NoError(t, err)
+	keys, err = server.ListAPIKeys(ctx, &proto.ListAPIKeysRequest{Token: token, User: "etl"})
+	assert.NoError(t, err)
+	assert.Empty(t, keys.GetKeys())
+}
@@ -0,0 +1,183 @@
+package name
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// auditedServices are the gRPC services whose calls are audited.
+var auditedServices = []string{"/name.Name/", "/admin.Admin/"}
+
+type auditSubjectContextKey struct{}
+
+// auditSubject collects who made a call while it passes the interceptors and
+// the handler.
+type auditSubject struct {
+	user  string
+	proxy string
+}
+
+// recordAuditUser notes the user a call is checked as, if the call is
+// audited.
+func recordAuditUser(ctx context.Context, user string) {
+	if subject, ok := ctx.Value(auditSubjectContextKey{}).(*auditSubject); ok {
+		subject.user = user
+	}
+}
+
+// recordAuditProxy notes the service account acting for the user of a call,
+// if the call is audited.
+func recordAuditProxy(ctx context.Context, proxy string) {
+	if subject, ok := ctx.Value(auditSubjectContextKey{}).(*auditSubject); ok {
+		subject.proxy = proxy
+	}
+}
+
+type AuditInterceptorOpts struct {
+	Logger   *logrus.Logger
+	AuditLog AuditLog
+}
+
+func (o *AuditInterceptorOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.AuditLog == nil {
+		return fmt.Errorf("audit log is required")
+	}
+
+	return nil
+}
+
+// AuditInterceptor records an AuditEvent for every call of the Name and Admin
+// services. It must be the first interceptor, so that calls rejected by the
+// auth interceptors are recorded too.
+type AuditInterceptor struct {
+	opts AuditInterceptorOpts
+}
+
+func NewAuditInterceptor(opts AuditInterceptorOpts) (*AuditInterceptor, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	return &AuditInterceptor{opts: opts}, nil
+}
+
+func isAudited(method string) bool {
+	for _, service := range auditedServices {
+		if strings.HasPrefix(method, service) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// auditOperation turns "/name.Name/CreateFile" into "Name.CreateFile".
+func auditOperation(method string) string {
+	method = strings.TrimPrefix(method, "/")
+	_, operation, found := strings.Cut(method, ".")
+	if !found {
+		return method
+	}
+
+	return strings.Replace(operation, "/", ".", 1)
+}
+
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// auditResult tells denied calls from calls that failed otherwise. The file
+// service reports denied operations in the error message only.
+func auditResult(err error) string {
+	if err == nil {
+		return AuditAllowed
+	}
+
+	switch status.Code(err) {
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return AuditDenied
+	}
+
+	if strings.Contains(err.Error(), "permission denied") {
+		return AuditDenied
+	}
+
+	return AuditFailed
+}
+
+func newAuditEvent(ctx context.Context, method string, req any, subject *auditSubject, err error) AuditEvent {
+	event := AuditEvent{
+		Time:      time.Now(),
+		User:      subject.user,
+		Proxy:     subject.proxy,
+		ClientIP:  clientIP(ctx),
+		Operation: auditOperation(method),
+		Result:    auditResult(err),
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	if r, ok := req.(interface{ GetPath() string }); ok {
+		event.Path = r.GetPath()
+	}
+	if r, ok := req.(interface{ GetDestination() string }); ok {
+		event.Destination = r.GetDestination()
+	}
+	if r, ok := req.(interface{ GetGroup() string }); ok {
+		event.TargetGroup = r.GetGroup()
+	}
+	if r, ok := req.(interface{ GetUser() string }); ok {
+		event.TargetUser = r.GetUser()
+	}
+
+	// the user logging in is the subject, not a target
+	if login, ok := req.(*proto.LoginRequest); ok {
+		if event.User == "" {
+			event.User = login.GetUser()
+		}
+		event.TargetUser = ""
+	}
+
+	return event
+}
+
+func (a *AuditInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isAudited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		subject := &auditSubject{}
+		resp, err := handler(context.WithValue(ctx, auditSubjectContextKey{}, subject), req)
+
+		a.opts.AuditLog.Record(newAuditEvent(ctx, info.FullMethod, req, subject, err))
+
+		return resp, err
+	}
+}
@@ -0,0 +1,168 @@
+package name_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditLog_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := name.NewAuditLog(name.AuditLogOpts{
+		Logger:     logrus.New(),
+		File:       file,
+		MaxSize:    512,
+		MaxBackups: 2,
+	})
+	assert.NoError(t, err)
+	defer auditLog.Close()
+
+	for i := 0; i < 20; i++ {
+		auditLog.Record(name.AuditEvent{
+			Time:      time.Now(),
+			User:      "alice",
+			Operation: "Name.CreateFile",
+			Path:      fmt.Sprintf("/data/%d.txt", i),
+			Result:    name.AuditAllowed,
+		})
+	}
+	auditLog.Record(name.AuditEvent{
+		Time:      time.Now(),
+		User:      "bob",
+		Operation: "Name.Stat",
+		Path:      "/data/19.txt",
+		Result:    name.AuditDenied,
+	})
+
+	assert.FileExists(t, file+".1")
+	assert.FileExists(t, file+".2")
+	assert.NoFileExists(t, file+".3")
+
+	events, err := auditLog.Query(name.AuditQuery{User: "alice", Limit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, "/data/19.txt", events[0].Path)
+	assert.Equal(t, "/data/17.txt", events[2].Path)
+
+	events, err = auditLog.Query(name.AuditQuery{Path: "/data/19.txt"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "bob", events[0].User)
+	assert.Equal(t, name.AuditDenied, events[0].Result)
+
+	events, err = auditLog.Query(name.AuditQuery{Path: "/dat"})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestAuditLog_DB(t *testing.T) {
+	db := createSecurityDB(t)
+	assert.NoError(t, db.AutoMigrate(name.AuditEvent{}))
+
+	auditLog, err := name.NewAuditLog(name.AuditLogOpts{Logger: logrus.New(), DB: db})
+	assert.NoError(t, err)
+	defer auditLog.Close()
+
+	now := time.Now()
+	auditLog.Record(name.AuditEvent{Time: now.Add(-2 * time.Second), User: "alice", Operation: "Name.CreateDir", Path: "/a_b", Result: name.AuditAllowed})
+	auditLog.Record(name.AuditEvent{Time: now.Add(-1 * time.Second), User: "alice", Operation: "Name.CreateFile", Path: "/a_b/c.txt", Result: name.AuditAllowed})
+	auditLog.Record(name.AuditEvent{Time: now, User: "bob", Operation: "Name.CreateFile", Path: "/axb/c.txt", Result: name.AuditDenied})
+
+	events, err := auditLog.Query(name.AuditQuery{Path: "/a_b"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "/a_b/c.txt", events[0].Path)
+
+	// the newer /axb/c.txt does not match and does not count to the limit
+	events, err = auditLog.Query(name.AuditQuery{Path: "/a_b", Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "/a_b/c.txt", events[0].Path)
+
+	auditLog.Record(name.AuditEvent{Time: now, User: "carol", Operation: "Name.CreateFile", Path: "/100%!/c.txt", Result: name.AuditAllowed})
+	auditLog.Record(name.AuditEvent{Time: now, User: "carol", Operation: "Name.CreateFile", Path: "/1000!/c.txt", Result: name.AuditAllowed})
+	events, err = auditLog.Query(name.AuditQuery{Path: "/100%!"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "/100%!/c.txt", events[0].Path)
+
+	events, err = auditLog.Query(name.AuditQuery{User: "bob"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, name.AuditDenied, events[0].Result)
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	assert.NoError(t, securityService.CreateUser(name.User{Name: "alice", Password: "secret"}))
+	token, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	auditLog, err := name.NewAuditLog(name.AuditLogOpts{
+		Logger: logrus.New(),
+		File:   filepath.Join(t.TempDir(), "audit.log"),
+	})
+	assert.NoError(t, err)
+	defer auditLog.Close()
+
+	auditInterceptor, err := name.NewAuditInterceptor(name.AuditInterceptorOpts{Logger: logrus.New(), AuditLog: auditLog})
+	assert.NoError(t, err)
+	authInterceptor, err := name.NewAuthInterceptor(name.AuthInterceptorOpts{Logger: logrus.New(), SecurityService: securityService})
+	assert.NoError(t, err)
+
+	call := func(ctx context.Context, method string, req any, handlerErr error) {
+		handler := func(ctx context.Context, req any) (any, error) {
+			return nil, handlerErr
+		}
+		_, _ = auditInterceptor.Unary()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			return authInterceptor.Unary()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		})
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(proto.AuthorizationHeader, proto.BearerPrefix+token))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4711}})
+
+	call(ctx, "/name.Name/CreateFile", &proto.CreateFileRequest{Path: "/a.txt"}, nil)
+	call(ctx, "/name.Name/DeleteFile", &proto.DeleteFileRequest{Path: "/b.txt"}, fmt.Errorf("permission denied"))
+	call(ctx, "/admin.Admin/AddUserToGroup", &proto.AddUserToGroupRequest{User: "bob", Group: "staff"}, status.Error(codes.PermissionDenied, "not a superuser"))
+	call(ctx, "/notification.Notification/BlockReport", nil, nil)
+
+	invalid := metadata.NewIncomingContext(context.Background(), metadata.Pairs(proto.AuthorizationHeader, "Basic xyz"))
+	call(invalid, "/name.Name/List", &proto.ListRequest{Path: "/"}, nil)
+
+	events, err := auditLog.Query(name.AuditQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+
+	assert.Equal(t, "Name.List", events[0].Operation)
+	assert.Equal(t, "", events[0].User)
+	assert.Equal(t, name.AuditDenied, events[0].Result)
+
+	assert.Equal(t, "Admin.AddUserToGroup", events[1].Operation)
+	assert.Equal(t, "alice", events[1].User)
+	assert.Equal(t, "bob", events[1].TargetUser)
+	assert.Equal(t, "staff", events[1].TargetGroup)
+	assert.Equal(t, name.AuditDenied, events[1].Result)
+
+	assert.Equal(t, "/b.txt", events[2].Path)
+	assert.Equal(t, name.AuditDenied, events[2].Result)
+
+	assert.Equal(t, "Name.CreateFile", events[3].Operation)
+	assert.Equal(t, "alice", events[3].User)
+	assert.Equal(t, "10.0.0.1", events[3].ClientIP)
+	assert.Equal(t, "/a.txt", events[3].Path)
+	assert.Equal(t, name.AuditAllowed, events[3].Result)
+}
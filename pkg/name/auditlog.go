@@ -0,0 +1,342 @@
+package name
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	AuditAllowed = "allowed"
+	AuditDenied  = "denied"
+	AuditFailed  = "failed"
+
+	DefaultAuditMaxSize    = 100 * 1024 * 1024
+	DefaultAuditMaxBackups = 5
+	DefaultAuditQueryLimit = 100
+	maxAuditQueryLimit     = 1000
+)
+
+// AuditEvent records a call of a Name or Admin RPC.
+type AuditEvent struct {
+	ID   uint64    `gorm:"autoIncrement;primaryKey" json:"-"`
+	Time time.Time `gorm:"column:occurred_at;not null;index" json:"time"`
+	// User is the user the call was checked as, Proxy the service account
+	// that acted for it, if any.
+	User     string `gorm:"column:user_name;not null;index" json:"user"`
+	Proxy    string `gorm:"not null;default:''" json:"proxy,omitempty"`
+	ClientIP string `gorm:"not null;default:''" json:"clientIp,omitempty"`
+	// Operation is the name of the RPC, as in "Name.CreateFile".
+	Operation   string `gorm:"not null" json:"operation"`
+	Path        string `gorm:"not null;default:'';index" json:"path,omitempty"`
+	Destination string `gorm:"not null;default:''" json:"destination,omitempty"`
+	// TargetUser and TargetGroup are the subjects of security operations.
+	TargetUser  string `gorm:"not null;default:''" json:"targetUser,omitempty"`
+	TargetGroup string `gorm:"not null;default:''" json:"targetGroup,omitempty"`
+	Result      string `gorm:"not null" json:"result"`
+	Error       string `gorm:"not null;default:''" json:"error,omitempty"`
+}
+
+// AuditQuery selects events of a user, at or below a path, or both.
+type AuditQuery struct {
+	User  string
+	Path  string
+	Limit int
+}
+
+func (q AuditQuery) matches(event AuditEvent) bool {
+	if q.User != "" && event.User != q.User {
+		return false
+	}
+	if q.Path != "" && !isPathOrBelow(event.Path, q.Path) && !isPathOrBelow(event.Destination, q.Path) {
+		return false
+	}
+
+	return true
+}
+
+func isPathOrBelow(path string, parent string) bool {
+	parent = strings.TrimSuffix(parent, "/")
+
+	return path != "" && (path == parent || strings.HasPrefix(path, parent+"/"))
+}
+
+type AuditLog interface {
+	// Record appends the event. Failures are logged, they do not fail the
+	// audited call.
+	Record(event AuditEvent)
+	// Query returns the most recent matching events, newest first.
+	Query(query AuditQuery) ([]AuditEvent, error)
+	Close() error
+}
+
+type AuditLogOpts struct {
+	Logger *logrus.Logger
+	// File is the JSON lines file the events are appended to, none when
+	// empty.
+	File string
+	// MaxSize is the size in bytes after which File is rotated.
+	// DefaultAuditMaxSize is used when 0.
+	MaxSize int64
+	// MaxBackups is the number of rotated files kept as File.1, File.2 and
+	// so on. DefaultAuditMaxBackups is used when 0.
+	MaxBackups int
+	// DB optionally stores the events too. Queries use it if set.
+	DB *gorm.DB
+}
+
+func (o *AuditLogOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.File == "" && o.DB == nil {
+		return fmt.Errorf("file or db is required")
+	}
+	if o.MaxSize < 0 {
+		return fmt.Errorf("max size must not be negative")
+	}
+	if o.MaxBackups < 0 {
+		return fmt.Errorf("max backups must not be negative")
+	}
+
+	return nil
+}
+
+type auditLog struct {
+	opts AuditLogOpts
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+var _ AuditLog = &auditLog{}
+
+func NewAuditLog(opts AuditLogOpts) (AuditLog, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+	if opts.MaxSize == 0 {
+		opts.MaxSize = DefaultAuditMaxSize
+	}
+	if opts.MaxBackups == 0 {
+		opts.MaxBackups = DefaultAuditMaxBackups
+	}
+
+	a := &auditLog{opts: opts}
+
+	if opts.File != "" {
+		err = a.open()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+func (a *auditLog) open() error {
+	file, err := os.OpenFile(a.opts.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open %s: %w", a.opts.File, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("could not stat %s: %w", a.opts.File, err)
+	}
+
+	a.file = file
+	a.size = info.Size()
+
+	return nil
+}
+
+func (a *auditLog) backup(n int) string {
+	return fmt.Sprintf("%s.%d", a.opts.File, n)
+}
+
+// rotate renames File to File.1 after moving the older backups up by one,
+// dropping the oldest.
+func (a *auditLog) rotate() error {
+	err := a.file.Close()
+	if err != nil {
+		return fmt.Errorf("could not close %s: %w", a.opts.File, err)
+	}
+
+	for n := a.opts.MaxBackups - 1; n > 0; n-- {
+		err = os.Rename(a.backup(n), a.backup(n+1))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("could not rotate %s: %w", a.backup(n), err)
+		}
+	}
+
+	err = os.Rename(a.opts.File, a.backup(1))
+	if err != nil {
+		return fmt.Errorf("could not rotate %s: %w", a.opts.File, err)
+	}
+
+	return a.open()
+}
+
+func (a *auditLog) write(event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("could not marshal event: %w", err)
+	}
+	line = append(line, '\n')
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.file == nil {
+		return fmt.Errorf("%s is closed", a.opts.File)
+	}
+
+	if a.size > 0 && a.size+int64(len(line)) > a.opts.MaxSize {
+		err = a.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := a.file.Write(line)
+	a.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("could not write %s: %w", a.opts.File, err)
+	}
+
+	return nil
+}
+
+func (a *auditLog) Record(event AuditEvent) {
+	if a.opts.File != "" {
+		err := a.write(event)
+		if err != nil {
+			a.opts.Logger.WithError(err).WithField("event", event).Error("Failed to write audit event")
+		}
+	}
+
+	if a.opts.DB != nil {
+		err := a.opts.DB.Create(&event).Error
+		if err != nil {
+			a.opts.Logger.WithError(err).WithField("event", event).Error("Failed to store audit event")
+		}
+	}
+}
+
+func (a *auditLog) Query(query AuditQuery) ([]AuditEvent, error) {
+	if query.Limit <= 0 {
+		query.Limit = DefaultAuditQueryLimit
+	}
+	if query.Limit > maxAuditQueryLimit {
+		query.Limit = maxAuditQueryLimit
+	}
+
+	if a.opts.DB != nil {
+		return a.queryDB(query)
+	}
+
+	return a.queryFiles(query)
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns. The escape character
+// is ! as a backslash is an escape character of MySQL string literals.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func (a *auditLog) queryDB(query AuditQuery) ([]AuditEvent, error) {
+	var events []AuditEvent
+
+	err := a.opts.DB.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Order("occurred_at DESC, id DESC").Limit(query.Limit)
+		if query.User != "" {
+			tx = tx.Where("user_name = ?", query.User)
+		}
+		if query.Path != "" {
+			path := strings.TrimSuffix(query.Path, "/")
+			below := likeEscaper.Replace(path) + "/%"
+			tx = tx.Where("(path <> '' AND (path = ? OR path LIKE ? ESCAPE '!')) OR (destination <> '' AND (destination = ? OR destination LIKE ? ESCAPE '!'))",
+				path, below, path, below)
+		}
+
+		return tx.Find(&events).Error
+	}, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not query audit events: %w", err)
+	}
+
+	return events, nil
+}
+
+// queryFiles scans the backups, oldest first, and File for the events.
+func (a *auditLog) queryFiles(query AuditQuery) ([]AuditEvent, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var events []AuditEvent
+
+	files := []string{}
+	for n := a.opts.MaxBackups; n > 0; n-- {
+		files = append(files, a.backup(n))
+	}
+	files = append(files, a.opts.File)
+
+	for _, file := range files {
+		f, err := os.Open(file)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not open %s: %w", file, err)
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			event := AuditEvent{}
+			err = json.Unmarshal(scanner.Bytes(), &event)
+			if err != nil || !query.matches(event) {
+				continue
+			}
+
+			events = append(events, event)
+			if len(events) > query.Limit {
+				events = events[1:]
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", file, err)
+		}
+	}
+
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+
+	return events, nil
+}
+
+func (a *auditLog) Close() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	err := a.file.Close()
+	a.file = nil
+
+	return err
+}
@@ -0,0 +1,187 @@
+package name
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the principal.
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	recordAuditUser(ctx, principal.User())
+
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal put into ctx by the auth
+// interceptors.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+type AuthInterceptorOpts struct {
+	Logger          *logrus.Logger
+	SecurityService SecurityService
+	// ProxyUsers maps the service accounts that may act for other users to
+	// whom they may impersonate.
+	ProxyUsers map[string]ProxyUser
+}
+
+func (o *AuthInterceptorOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.SecurityService == nil {
+		return fmt.Errorf("security service is required")
+	}
+
+	return nil
+}
+
+// AuthInterceptor resolves the bearer token in the authorization metadata of
+// incoming calls and puts the principal into the context of the handler.
+// Calls without a bearer token are passed on unchanged, so that handlers can
+// fall back to the deprecated token request field. Proxy users may name the
+// user they act for in the doAs metadata, whose principal is used instead.
+type AuthInterceptor struct {
+	opts AuthInterceptorOpts
+}
+
+func NewAuthInterceptor(opts AuthInterceptorOpts) (*AuthInterceptor, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	return &AuthInterceptor{opts: opts}, nil
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	values := md.Get(proto.AuthorizationHeader)
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	if !strings.HasPrefix(values[0], proto.BearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "authorization is not a bearer token")
+	}
+
+	token := strings.TrimPrefix(values[0], proto.BearerPrefix)
+	user, err := a.opts.SecurityService.LookupUserByToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	doAs := md.Get(proto.DoAsHeader)
+	if len(doAs) > 0 && doAs[0] != user.Name {
+		recordAuditUser(ctx, doAs[0])
+		recordAuditProxy(ctx, user.Name)
+		user, err = a.impersonate(user, doAs[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ContextWithPrincipal(ctx, NewPrincipal(user)), nil
+}
+
+// impersonate returns the user proxy acts for if proxy may impersonate it.
+// Delegated tokens may not impersonate anyone.
+func (a *AuthInterceptor) impersonate(proxy User, userName string) (User, error) {
+	if proxy.Scope != nil {
+		return User{}, status.Errorf(codes.PermissionDenied, "delegated token of %s may not impersonate %s", proxy.Name, userName)
+	}
+
+	proxyUser, ok := a.opts.ProxyUsers[proxy.Name]
+	if !ok {
+		return User{}, status.Errorf(codes.PermissionDenied, "user %s is not a proxy user", proxy.Name)
+	}
+
+	user, err := a.opts.SecurityService.GetUser(userName)
+	if err != nil {
+		return User{}, status.Errorf(codes.PermissionDenied, "user %s may not impersonate %s: %v", proxy.Name, userName, err)
+	}
+
+	if !proxyUser.Allows(user) {
+		return User{}, status.Errorf(codes.PermissionDenied, "user %s may not impersonate %s", proxy.Name, userName)
+	}
+
+	a.opts.Logger.WithFields(logrus.Fields{
+		"proxy": proxy.Name,
+		"user":  userName,
+	}).Debug("Impersonating user")
+
+	return user, nil
+}
+
+func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			a.opts.Logger.WithError(err).WithField("method", info.FullMethod).Warn("Authentication failed")
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(stream.Context())
+		if err != nil {
+			a.opts.Logger.WithError(err).WithField("method", info.FullMethod).Warn("Authentication failed")
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// resolvePrincipal returns the principal authenticated by the interceptors or,
+// for clients still sending the deprecated token field, the one of the token.
+func resolvePrincipal(ctx context.Context, logger *logrus.Logger, securityService SecurityService, token string) (Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if ok {
+		return principal, nil
+	}
+
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "no bearer token provided")
+	}
+
+	logger.Debug("Request uses deprecated token field instead of bearer token")
+
+	user, err := securityService.LookupUserByToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to lookup user: %v", err)
+	}
+
+	recordAuditUser(ctx, user.Name)
+
+	return NewPrincipal(user), nil
+}
@@ -0,0 +1,75 @@
+package name_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func incomingContext(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(proto.AuthorizationHeader, authorization))
+}
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	interceptor, err := name.NewAuthInterceptor(name.AuthInterceptorOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+	})
+	assert.NoError(t, err)
+
+	err = securityService.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+	token, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/name.Name/List"}
+	var principal name.Principal
+	handler := func(ctx context.Context, req any) (any, error) {
+		principal, _ = name.PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+
+	resp, err := interceptor.Unary()(incomingContext(proto.BearerPrefix+token), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.NotNil(t, principal)
+
+	principal = nil
+	_, err = interceptor.Unary()(context.Background(), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Nil(t, principal)
+
+	_, err = interceptor.Unary()(incomingContext(proto.BearerPrefix+"invalid"), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor.Unary()(incomingContext("Basic "+token), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAdminServer_PrincipalFromContext(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	server := createAdminServer(t, securityService)
+
+	_, err := name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+
+	user, err := securityService.GetUser("admin")
+	assert.NoError(t, err)
+	ctx := name.ContextWithPrincipal(context.Background(), name.NewPrincipal(user))
+
+	users, err := server.ListUsers(ctx, &proto.ListUsersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, users.Users, 1)
+
+	_, err = server.ListUsers(context.Background(), &proto.ListUsersRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,74 @@
+package name
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownUser is returned by authenticators for users they do not know,
+// so that a chain goes on with the next authenticator.
+var ErrUnknownUser = errors.New("unknown user")
+
+// Credentials are presented by clients logging in. Depending on the
+// authenticator the password is a password or a token of an identity
+// provider.
+type Credentials struct {
+	User     string
+	Password string
+}
+
+// Authenticator verifies credentials against an identity source and returns
+// the user with the groups known to the source.
+type Authenticator interface {
+	Authenticate(credentials Credentials) (User, error)
+}
+
+type dbAuthenticator struct {
+	securityService SecurityService
+}
+
+var _ Authenticator = &dbAuthenticator{}
+
+// NewDBAuthenticator authenticates the local users by their stored password.
+func NewDBAuthenticator(securityService SecurityService) Authenticator {
+	return &dbAuthenticator{securityService: securityService}
+}
+
+func (a *dbAuthenticator) Authenticate(credentials Credentials) (User, error) {
+	return a.securityService.VerifyPassword(credentials.User, credentials.Password)
+}
+
+type chainAuthenticator struct {
+	authenticators []Authenticator
+}
+
+var _ Authenticator = &chainAuthenticator{}
+
+// NewChainAuthenticator tries the authenticators in order and returns the user
+// of the first one accepting the credentials. The chain only goes on for
+// users an authenticator does not know, any other failure, such as a wrong
+// password, is returned right away.
+func NewChainAuthenticator(authenticators ...Authenticator) Authenticator {
+	return &chainAuthenticator{authenticators: authenticators}
+}
+
+func (a *chainAuthenticator) Authenticate(credentials Credentials) (User, error) {
+	if len(a.authenticators) == 0 {
+		return User{}, fmt.Errorf("no authenticators configured")
+	}
+
+	var errs []error
+
+	for _, authenticator := range a.authenticators {
+		user, err := authenticator.Authenticate(credentials)
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, ErrUnknownUser) {
+			return User{}, err
+		}
+		errs = append(errs, err)
+	}
+
+	return User{}, errors.Join(errs...)
+}
@@ -0,0 +1,268 @@
+package name_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func writeFile(t *testing.T, dir string, fileName string, content string) string {
+	path := filepath.Join(dir, fileName)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func createHtpasswdAuthenticator(t *testing.T) name.Authenticator {
+	dir := t.TempDir()
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	authenticator, err := name.NewHtpasswdAuthenticator(name.HtpasswdAuthenticatorOpts{
+		Logger: logrus.New(),
+		File: writeFile(t, dir, "htpasswd", "# users\n"+
+			"carol:"+string(hash)+"\n"+
+			// sha1 of "password"
+			"dave:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"),
+		GroupFile: writeFile(t, dir, "htgroup", "staff: carol dave\nops: carol\n"),
+	})
+	assert.NoError(t, err)
+
+	return authenticator
+}
+
+func TestHtpasswdAuthenticator(t *testing.T) {
+	authenticator := createHtpasswdAuthenticator(t)
+
+	user, err := authenticator.Authenticate(name.Credentials{User: "carol", Password: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "carol", user.Name)
+	assert.Equal(t, name.HtpasswdProvider, user.Provider)
+	assert.Len(t, user.Groups, 2)
+
+	user, err = authenticator.Authenticate(name.Credentials{User: "dave", Password: "password"})
+	assert.NoError(t, err)
+	assert.Len(t, user.Groups, 1)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "carol", Password: "wrong"})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, name.ErrUnknownUser)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "eve", Password: "secret"})
+	assert.ErrorIs(t, err, name.ErrUnknownUser)
+}
+
+func TestNewHtpasswdAuthenticator_InvalidFile(t *testing.T) {
+	_, err := name.NewHtpasswdAuthenticator(name.HtpasswdAuthenticatorOpts{
+		Logger: logrus.New(),
+		File:   writeFile(t, t.TempDir(), "htpasswd", "no separator\n"),
+	})
+	assert.Error(t, err)
+}
+
+func base64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signJWT(t *testing.T, alg string, kid string, key crypto.Signer, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	assert.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	assert.NoError(t, err)
+
+	signed := base64URL(header) + "." + base64URL(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		assert.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		assert.NoError(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+
+	return signed + "." + base64URL(signature)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	keySet, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{
+			"kty": "RSA",
+			"kid": "rsa",
+			"use": "sig",
+			"n":   base64URL(rsaKey.N.Bytes()),
+			"e":   base64URL(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+		{
+			"kty": "EC",
+			"kid": "ec",
+			"crv": "P-256",
+			"x":   base64URL(ecKey.X.FillBytes(make([]byte, 32))),
+			"y":   base64URL(ecKey.Y.FillBytes(make([]byte, 32))),
+		},
+	}})
+	assert.NoError(t, err)
+
+	authenticator, err := name.NewJWTAuthenticator(name.JWTAuthenticatorOpts{
+		Logger:   logrus.New(),
+		JWKSFile: writeFile(t, t.TempDir(), "jwks.json", string(keySet)),
+		Issuer:   "https://idp.example.com",
+		Audience: "dfs",
+	})
+	assert.NoError(t, err)
+
+	claims := map[string]any{
+		"iss":    "https://idp.example.com",
+		"aud":    []string{"dfs", "other"},
+		"sub":    "frank",
+		"groups": []string{"staff"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+
+	user, err := authenticator.Authenticate(name.Credentials{Password: signJWT(t, "RS256", "rsa", rsaKey, claims)})
+	assert.NoError(t, err)
+	assert.Equal(t, "frank", user.Name)
+	assert.Equal(t, name.JWTProvider, user.Provider)
+	assert.Len(t, user.Groups, 1)
+	assert.Equal(t, "staff", user.Groups[0].Name)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "frank", Password: signJWT(t, "ES256", "ec", ecKey, claims)})
+	assert.NoError(t, err)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "grace", Password: signJWT(t, "ES256", "ec", ecKey, claims)})
+	assert.Error(t, err)
+
+	_, err = authenticator.Authenticate(name.Credentials{Password: signJWT(t, "RS256", "rsa", otherKey, claims)})
+	assert.Error(t, err)
+
+	_, err = authenticator.Authenticate(name.Credentials{Password: signJWT(t, "ES256", "rsa", ecKey, claims)})
+	assert.Error(t, err)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "frank", Password: "secret"})
+	assert.ErrorIs(t, err, name.ErrUnknownUser)
+
+	expired := map[string]any{}
+	for key, value := range claims {
+		expired[key] = value
+	}
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = authenticator.Authenticate(name.Credentials{Password: signJWT(t, "RS256", "rsa", rsaKey, expired)})
+	assert.Error(t, err)
+
+	wrongAudience := map[string]any{}
+	for key, value := range claims {
+		wrongAudience[key] = value
+	}
+	wrongAudience["aud"] = "other"
+	_, err = authenticator.Authenticate(name.Credentials{Password: signJWT(t, "RS256", "rsa", rsaKey, wrongAudience)})
+	assert.Error(t, err)
+}
+
+func TestChainAuthenticator(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	err := securityService.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	authenticator := name.NewChainAuthenticator(
+		name.NewDBAuthenticator(securityService),
+		createHtpasswdAuthenticator(t))
+
+	user, err := authenticator.Authenticate(name.Credentials{User: "alice", Password: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", user.Provider)
+
+	user, err = authenticator.Authenticate(name.Credentials{User: "carol", Password: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, name.HtpasswdProvider, user.Provider)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "carol", Password: "wrong"})
+	assert.Error(t, err)
+
+	_, err = name.NewChainAuthenticator().Authenticate(name.Credentials{User: "alice", Password: "secret"})
+	assert.Error(t, err)
+}
+
+func TestChainAuthenticator_WrongPassword(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	err := securityService.CreateUser(name.User{Name: "carol", Password: "local"})
+	assert.NoError(t, err)
+
+	authenticator := name.NewChainAuthenticator(
+		name.NewDBAuthenticator(securityService),
+		createHtpasswdAuthenticator(t))
+
+	// carol is known to the database, the htpasswd password is not tried
+	_, err = authenticator.Authenticate(name.Credentials{User: "carol", Password: "secret"})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, name.ErrUnknownUser)
+
+	user, err := authenticator.Authenticate(name.Credentials{User: "carol", Password: "local"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", user.Provider)
+
+	_, err = authenticator.Authenticate(name.Credentials{User: "nobody", Password: "secret"})
+	assert.ErrorIs(t, err, name.ErrUnknownUser)
+}
+
+func TestServer_LoginWithProvider(t *testing.T) {
+	ctx := context.Background()
+	securityService := createSecurityService(t, createSecurityDB(t))
+	err := securityService.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	server := name.Server{Opts: name.ServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		Authenticator: name.NewChainAuthenticator(
+			name.NewDBAuthenticator(securityService),
+			createHtpasswdAuthenticator(t)),
+	}}
+
+	resp, err := server.Login(ctx, &proto.LoginRequest{User: "carol", HashedPassword: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "carol", resp.GetUser())
+
+	user, err := securityService.LookupUserByToken(resp.GetToken())
+	assert.NoError(t, err)
+	assert.Equal(t, name.HtpasswdProvider, user.Provider)
+	assert.Len(t, user.Groups, 2)
+
+	// Provisioned users can not log in with a local password
+	_, err = securityService.AuthenticateUser("carol", "")
+	assert.Error(t, err)
+
+	resp, err = server.Login(ctx, &proto.LoginRequest{User: "alice", HashedPassword: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", resp.GetUser())
+
+	_, err = securityService.IssueToken(name.User{Name: "alice", Provider: name.HtpasswdProvider})
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -24,16 +25,26 @@ type BlockReport struct {
 type FileService interface {
 	Stat(p Principal, path string) (FileInfo, error)
 	List(p Principal, path string) ([]FileInfo, error)
-	CreateFile(p Principal, path string, perms Permissions) (FileInfo, error)
-	CreateDir(p Principal, path string, perms Permissions) (FileInfo, error)
+	CreateFile(p Principal, path string, perms *Permissions) (FileInfo, error)
+	CreateDir(p Principal, path string, perms *Permissions) (FileInfo, error)
 	DeleteFile(p Principal, path string) error
 	DeleteDir(p Principal, path string) error
+	SetPermission(p Principal, path string, ownerPermission Permission, groupPermission Permission, otherPermission Permission, sticky bool, setGID bool, recursive bool) error
+	SetOwner(p Principal, path string, owner string, group string, recursive bool) error
+	GetACL(p Principal, path string) ([]ACLEntry, error)
+	SetACL(p Principal, path string, entries []ACLEntry) error
+	RemoveACL(p Principal, path string, entries []ACLEntry) error
 	GetBlockInfos(p Principal, path string) ([]BlockInfo, error)
 	NotifyBlockPresent(n *proto.NotifyBlockPresentRequest) error
 	NotifyBlockAdded(n *proto.NotifyBlockAddedRequest) error
 	NotifyBlockRemoved(n *proto.NotifyBlockRemovedRequest) error
 	NodeRemoved(host string) error
 	GetAllBlockInfos() ([]BlockInfo, error)
+	SetQuota(p Principal, path string, namespace uint64, space uint64) error
+	SetUserQuota(p Principal, user string, space uint64) error
+	GetUserQuota(p Principal, user string) (UserQuota, error)
+	GetContentSummary(p Principal, path string) (ContentSummary, error)
+	ReserveBlock(path string, blockID string, length uint64, expiresAt time.Time) error
 }
 
 type FileInfo struct {
@@ -46,9 +57,12 @@ type FileInfo struct {
 	Children    []FileInfo  `gorm:"foreignKey:ParentID"`
 	Permissions Permissions `gorm:"embedded;embeddedPrefix:permissions_"`
 	BlockInfos  []BlockInfo `gorm:"constraint:OnDelete:CASCADE"`
+	ACL         []ACLEntry  `gorm:"constraint:OnDelete:CASCADE"`
+	Quota       Quota       `gorm:"embedded;embeddedPrefix:quota_"`
 }
 
 var _ HasPermissions = &FileInfo{}
+var _ HasACL = &FileInfo{}
 
 func (fi *FileInfo) GetSize() uint64 {
 	size := uint64(0)
@@ -114,6 +128,10 @@ func (fi *FileInfo) BeforeSave(_ *gorm.DB) error {
 		if len(fi.Children) > 0 {
 			return fmt.Errorf("file cannot have children")
 		}
+
+		if fi.Permissions.Sticky || fi.Permissions.SetGID {
+			return fmt.Errorf("file cannot be sticky or setgid")
+		}
 	}
 
 	return nil
@@ -143,6 +161,10 @@ func (fi *FileInfo) GetPermissions() Permissions {
 	return fi.Permissions
 }
 
+func (fi *FileInfo) GetACL() []ACLEntry {
+	return fi.ACL
+}
+
 type BlockInfo struct {
 	ID         string     `gorm:"primaryKey;not null"`
 	CreatedAt  time.Time  `gorm:"autoCreateTime"`
@@ -201,6 +223,16 @@ func (l *Location) BeforeSave(_ *gorm.DB) error {
 type FileServiceOpts struct {
 	Logger *logrus.Logger
 	DB     *gorm.DB
+	// SuperuserGroup is the group whose members have root privileges, they
+	// may also create files owned by other users. DefaultSuperuserGroup is
+	// used when empty.
+	SuperuserGroup string
+	// Umask applies to files created without permissions by users without
+	// an umask of their own. DefaultUmask is used when empty.
+	Umask string
+	// Replication is the number of replicas of each block, by which space
+	// quotas are charged. 1 is used when 0.
+	Replication uint
 }
 
 func (f FileServiceOpts) Validate() error {
@@ -210,11 +242,18 @@ func (f FileServiceOpts) Validate() error {
 	if f.DB == nil {
 		return fmt.Errorf("db is required")
 	}
+	if f.Umask != "" {
+		_, err := ParseUmask(f.Umask)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 type fileService struct {
-	Opts FileServiceOpts
+	Opts  FileServiceOpts
+	umask Umask
 }
 
 var _ FileService = &fileService{}
@@ -224,12 +263,28 @@ func NewFileService(opts FileServiceOpts) (FileService, error) {
 		return nil, fmt.Errorf("invalid file service options: %w", err)
 	}
 
+	if opts.SuperuserGroup == "" {
+		opts.SuperuserGroup = DefaultSuperuserGroup
+	}
+	if opts.Umask == "" {
+		opts.Umask = DefaultUmask
+	}
+	if opts.Replication == 0 {
+		opts.Replication = 1
+	}
+
+	umask, err := ParseUmask(opts.Umask)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file service options: %w", err)
+	}
+
 	fileService := fileService{
-		Opts: opts,
+		Opts:  opts,
+		umask: umask,
 	}
 
 	//create root directory if it doesn't exist
-	err := opts.DB.Transaction(func(tx *gorm.DB) error {
+	err = opts.DB.Transaction(func(tx *gorm.DB) error {
 		rootDir, err := fileService.lookupRoot(tx)
 		if err != nil {
 			opts.Logger.WithError(err).Info("Could not find root directory. Creating...")
@@ -239,19 +294,22 @@ func NewFileService(opts FileServiceOpts) (FileService, error) {
 				Owner: "root",
 				Group: "root",
 				OwnerPermission: Permission{
-					Read:   true,
-					Write:  true,
-					Delete: true,
+					Read:    true,
+					Write:   true,
+					Delete:  true,
+					Execute: true,
 				},
 				GroupPermission: Permission{
-					Read:   true,
-					Write:  true,
-					Delete: true,
+					Read:    true,
+					Write:   true,
+					Delete:  true,
+					Execute: true,
 				},
 				OtherPermission: Permission{
-					Read:   true,
-					Write:  true,
-					Delete: true,
+					Read:    true,
+					Write:   true,
+					Delete:  true,
+					Execute: true,
 				},
 			}
 
@@ -358,41 +416,93 @@ func (f *fileService) lookup(tx *gorm.DB, path string) ([]FileInfo, error) {
 					currentDir)
 		}
 
-		fileInfos = append(fileInfos, child)
-		currentDir = child
-		err := tx.Model(&currentDir).Preload(clause.Associations).Error
+		currentDir = FileInfo{}
+		err := tx.Preload(clause.Associations).First(&currentDir, child.ID).Error
 		if err != nil {
-			return []FileInfo{}, fmt.Errorf("could not preload child %v", currentDir)
+			return []FileInfo{}, fmt.Errorf("could not preload child %v", child)
 		}
+		fileInfos = append(fileInfos, currentDir)
 
-		if !currentDir.IsDir {
-			break
+		if !currentDir.IsDir && len(fileInfos) <= len(parts) {
+			return []FileInfo{}, fmt.Errorf("'%s' of path '%s' is not a directory", part, path)
 		}
 	}
 
 	return fileInfos, nil
 }
 
-func (f *fileService) computePrivileges(p Principal, fileInfos ...FileInfo) Privileges {
-	hasPermissionsList := []HasPermissions{}
+// chainPath returns the path of the last of fileInfos, as returned by lookup.
+func chainPath(fileInfos []FileInfo) string {
+	var names []string
 
-	for _, fileInfo := range fileInfos {
-		hasPermissionsList = append(hasPermissionsList, &fileInfo)
+	for _, fileInfo := range fileInfos[1:] {
+		names = append(names, fileInfo.Name)
+	}
+
+	return "/" + strings.Join(names, "/")
+}
+
+// privileges returns what p may do with the last of fileInfos, as returned by
+// lookup, alone. Superusers may do everything. Delegated tokens are limited
+// to their scope in either case.
+func (f *fileService) privileges(p Principal, fileInfos []FileInfo) Privileges {
+	fileInfo := fileInfos[len(fileInfos)-1]
+	privileges := Privileges{Read: true, Write: true, Delete: true, Execute: true}
+
+	if !IsSuperuser(p, f.Opts.SuperuserGroup) {
+		privileges = p.ComputePrivileges(&fileInfo)
+	}
+
+	if scope := p.Scope(); scope != nil {
+		privileges = scope.Limit(chainPath(fileInfos), privileges)
+	}
+
+	return privileges
+}
+
+// canTraverse reports whether p may pass through each of the directories, as
+// returned by lookup.
+func (f *fileService) canTraverse(p Principal, dirs []FileInfo) bool {
+	for i := range dirs {
+		if !f.privileges(p, dirs[:i+1]).Execute {
+			return false
+		}
 	}
 
-	return p.ComputePrivileges(hasPermissionsList...)
+	return true
 }
 
-func (f *fileService) canRead(p Principal, fileInfos ...FileInfo) bool {
-	return f.computePrivileges(p, fileInfos...).Read
+// canRead reports whether p may reach the last of fileInfos, as returned by
+// lookup, and read it. Only the target needs to be readable, its ancestors
+// need to be traversable.
+func (f *fileService) canRead(p Principal, fileInfos []FileInfo) bool {
+	target := len(fileInfos) - 1
+
+	return f.canTraverse(p, fileInfos[:target]) && f.privileges(p, fileInfos).Read
 }
 
-func (f *fileService) canWrite(p Principal, fileInfos ...FileInfo) bool {
-	return f.computePrivileges(p, fileInfos...).Write
+// canModify reports whether p may create or delete entries in the last of
+// dirs, as returned by lookup. This takes traversing all dirs and writing the
+// last one.
+func (f *fileService) canModify(p Principal, dirs []FileInfo) bool {
+	return f.canTraverse(p, dirs) && f.privileges(p, dirs).Write
 }
 
-func (f *fileService) canDelete(p Principal, fileInfos ...FileInfo) bool {
-	return f.computePrivileges(p, fileInfos...).Delete
+// canRemove reports whether p may delete the last of fileInfos, as returned
+// by lookup. In a sticky directory only the owner of the entry, the owner of
+// the directory and superusers may do so.
+func (f *fileService) canRemove(p Principal, fileInfos []FileInfo) bool {
+	parent := fileInfos[len(fileInfos)-2]
+	target := fileInfos[len(fileInfos)-1]
+
+	if !f.canModify(p, fileInfos[:len(fileInfos)-1]) {
+		return false
+	}
+
+	return !parent.Permissions.Sticky ||
+		IsSuperuser(p, f.Opts.SuperuserGroup) ||
+		target.Permissions.Owner == p.User() ||
+		parent.Permissions.Owner == p.User()
 }
 
 func (f *fileService) Stat(p Principal, path string) (FileInfo, error) {
@@ -403,7 +513,7 @@ func (f *fileService) Stat(p Principal, path string) (FileInfo, error) {
 			return fmt.Errorf("failed to lookup %s: %w", path, err)
 		}
 
-		if !f.canRead(p, fileInfos...) {
+		if !f.canRead(p, fileInfos) {
 			return fmt.Errorf("permission denied for %s", path)
 		}
 
@@ -426,7 +536,7 @@ func (f *fileService) List(p Principal, path string) ([]FileInfo, error) {
 			return fmt.Errorf("failed to lookup dirs: %w", err)
 		}
 
-		if !f.canRead(p, fileInfos...) {
+		if !f.canRead(p, fileInfos) {
 			return fmt.Errorf("permission denied for %s", path)
 		}
 
@@ -446,7 +556,71 @@ func (f *fileService) List(p Principal, path string) ([]FileInfo, error) {
 	return children, nil
 }
 
-func (f *fileService) CreateFile(p Principal, path string, perms Permissions) (FileInfo, error) {
+// ownership sets the owner and the group of a new child of parent. The owner
+// is the caller unless a superuser names another one. The group defaults to
+// the group of parent if it is setgid or the caller is a member, else to the
+// primary group of the caller. Other users may only pick one of their own
+// groups.
+func (f *fileService) ownership(p Principal, parent FileInfo, perms Permissions) (Permissions, error) {
+	superuser := IsSuperuser(p, f.Opts.SuperuserGroup)
+	owner := strings.TrimSpace(perms.Owner)
+	group := strings.TrimSpace(perms.Group)
+
+	switch {
+	case owner == "":
+		perms.Owner = p.User()
+	case owner != p.User() && !superuser:
+		return Permissions{}, fmt.Errorf("only superusers may create files owned by %s", owner)
+	default:
+		perms.Owner = owner
+	}
+
+	groups := p.Groups()
+	switch {
+	case group == "" && (superuser || parent.Permissions.SetGID || slices.Contains(groups, parent.Permissions.Group)):
+		perms.Group = parent.Permissions.Group
+	case group == "" && len(groups) > 0:
+		perms.Group = groups[0]
+	case group == "":
+		perms.Group = parent.Permissions.Group
+	case !superuser && !slices.Contains(groups, group):
+		return Permissions{}, fmt.Errorf("user %s is not a member of group %s", p.User(), group)
+	default:
+		perms.Group = group
+	}
+
+	return perms, nil
+}
+
+// newPermissions returns the permissions of a new child of parent. Without
+// explicit ones, the bits are derived from the umask of the caller, or else
+// the one of the server, and the group class is limited by the default mask
+// of parent, as in POSIX ACLs.
+func (f *fileService) newPermissions(p Principal, parent FileInfo, perms *Permissions, isDir bool) (Permissions, error) {
+	if perms != nil {
+		return f.ownership(p, parent, *perms)
+	}
+
+	umask := f.umask
+	if p.Umask() != "" {
+		userUmask, err := ParseUmask(p.Umask())
+		if err != nil {
+			return Permissions{}, fmt.Errorf("umask of %s is invalid: %w", p.User(), err)
+		}
+		umask = userUmask
+	}
+
+	derived := umask.Apply(isDir)
+	for _, entry := range parent.ACL {
+		if entry.Default && entry.Type == ACLTypeMask {
+			derived.GroupPermission = intersectPermission(derived.GroupPermission, entry.Permission)
+		}
+	}
+
+	return f.ownership(p, parent, derived)
+}
+
+func (f *fileService) CreateFile(p Principal, path string, perms *Permissions) (FileInfo, error) {
 	var fileInfo FileInfo
 
 	_, parentPath, name, err := f.cleanPath(path)
@@ -466,15 +640,26 @@ func (f *fileService) CreateFile(p Principal, path string, perms Permissions) (F
 
 		parent := parents[len(parents)-1]
 
-		if !f.canWrite(p, parent) {
+		if !f.canModify(p, parents) {
 			return fmt.Errorf("permission denied")
 		}
 
+		permissions, err := f.newPermissions(p, parent, perms, false)
+		if err != nil {
+			return fmt.Errorf("permission denied: %w", err)
+		}
+
+		err = f.charge(tx, parents, permissions.Owner, 1, 0)
+		if err != nil {
+			return err
+		}
+
 		fileInfo = FileInfo{
 			Name:        name,
 			IsDir:       false,
 			ParentID:    &parent.ID,
-			Permissions: perms,
+			Permissions: permissions,
+			ACL:         inheritACL(parent, false),
 		}
 
 		err = tx.Create(&fileInfo).Error
@@ -491,7 +676,7 @@ func (f *fileService) CreateFile(p Principal, path string, perms Permissions) (F
 	return fileInfo, nil
 }
 
-func (f *fileService) CreateDir(p Principal, path string, perms Permissions) (FileInfo, error) {
+func (f *fileService) CreateDir(p Principal, path string, perms *Permissions) (FileInfo, error) {
 	var fileInfo FileInfo
 
 	_, parentPath, name, err := f.cleanPath(path)
@@ -511,15 +696,27 @@ func (f *fileService) CreateDir(p Principal, path string, perms Permissions) (Fi
 
 		parent := parents[len(parents)-1]
 
-		if !f.canWrite(p, parent) {
+		if !f.canModify(p, parents) {
 			return fmt.Errorf("permission denied")
 		}
 
+		permissions, err := f.newPermissions(p, parent, perms, true)
+		if err != nil {
+			return fmt.Errorf("permission denied: %w", err)
+		}
+		permissions.SetGID = permissions.SetGID || parent.Permissions.SetGID
+
+		err = f.charge(tx, parents, permissions.Owner, 1, 0)
+		if err != nil {
+			return err
+		}
+
 		fileInfo = FileInfo{
 			Name:        name,
 			IsDir:       true,
 			ParentID:    &parent.ID,
-			Permissions: perms,
+			Permissions: permissions,
+			ACL:         inheritACL(parent, true),
 		}
 
 		err = tx.Create(&fileInfo).Error
@@ -543,12 +740,12 @@ func (f *fileService) DeleteFile(p Principal, path string) error {
 			return fmt.Errorf("failed to lookup file: %w", err)
 		}
 
-		if !f.canDelete(p, fileInfos...) {
-			return fmt.Errorf("permission denied")
+		if len(fileInfos) < 2 {
+			return fmt.Errorf("can't delete the root directory")
 		}
 
-		if len(fileInfos) == 0 {
-			return fmt.Errorf("no file found")
+		if !f.canRemove(p, fileInfos) {
+			return fmt.Errorf("permission denied")
 		}
 
 		fileInfo := fileInfos[len(fileInfos)-1]
@@ -557,6 +754,22 @@ func (f *fileService) DeleteFile(p Principal, path string) error {
 			return fmt.Errorf("can't delete a directory with this call")
 		}
 
+		space := int64(f.space(fileInfo.GetSize()))
+		err = f.charge(tx, fileInfos[:len(fileInfos)-1], fileInfo.Permissions.Owner, -1, -space)
+		if err != nil {
+			return err
+		}
+
+		err = f.releaseReservations(tx, "file_info_id = ?", fileInfo.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("file_info_id = ?", fileInfo.ID).Delete(&ACLEntry{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete acl: %w", err)
+		}
+
 		err = tx.Delete(&fileInfo).Error
 		if err != nil {
 			return fmt.Errorf("failed to delete file: %w", err)
@@ -578,12 +791,12 @@ func (f *fileService) DeleteDir(p Principal, path string) error {
 			return fmt.Errorf("failed to lookup directory: %w", err)
 		}
 
-		if !f.canDelete(p, fileInfos...) {
-			return fmt.Errorf("permission denied")
+		if len(fileInfos) < 2 {
+			return fmt.Errorf("can't delete the root directory")
 		}
 
-		if len(fileInfos) == 0 {
-			return fmt.Errorf("no directory found")
+		if !f.canRemove(p, fileInfos) {
+			return fmt.Errorf("permission denied")
 		}
 
 		fileInfo := fileInfos[len(fileInfos)-1]
@@ -595,6 +808,17 @@ func (f *fileService) DeleteDir(p Principal, path string) error {
 		if len(fileInfo.Children) > 0 {
 			return fmt.Errorf("directory is not empty")
 		}
+
+		err = f.charge(tx, fileInfos[:len(fileInfos)-1], fileInfo.Permissions.Owner, -1, 0)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("file_info_id = ?", fileInfo.ID).Delete(&ACLEntry{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete acl: %w", err)
+		}
+
 		err = tx.Delete(&fileInfo).Error
 		if err != nil {
 			return fmt.Errorf("failed to delete: %w", err)
@@ -609,6 +833,187 @@ func (f *fileService) DeleteDir(p Principal, path string) error {
 	return nil
 }
 
+// subtree returns the file info and, if recursive, all its descendants.
+func (f *fileService) subtree(tx *gorm.DB, fileInfo FileInfo, recursive bool) ([]FileInfo, error) {
+	fileInfos := []FileInfo{fileInfo}
+	if !recursive {
+		return fileInfos, nil
+	}
+
+	parentIDs := []uint64{fileInfo.ID}
+	for len(parentIDs) > 0 {
+		var children []FileInfo
+		err := tx.Where("parent_id IN ?", parentIDs).Find(&children).Error
+		if err != nil {
+			return nil, fmt.Errorf("could not find children: %w", err)
+		}
+
+		parentIDs = nil
+		for _, child := range children {
+			fileInfos = append(fileInfos, child)
+			if child.IsDir {
+				parentIDs = append(parentIDs, child.ID)
+			}
+		}
+	}
+
+	return fileInfos, nil
+}
+
+func fileInfoIDs(fileInfos []FileInfo) []uint64 {
+	var ids []uint64
+
+	for _, fileInfo := range fileInfos {
+		ids = append(ids, fileInfo.ID)
+	}
+
+	return ids
+}
+
+// SetPermission changes the permissions of the owner, the group and others
+// of path, and if recursive of everything below it. The sticky and setgid
+// flags only apply to directories. Only the owner of each changed file or a
+// superuser may do so, delegated tokens only with write access in their
+// scope.
+func (f *fileService) SetPermission(p Principal, path string, ownerPermission Permission, groupPermission Permission, otherPermission Permission, sticky bool, setGID bool, recursive bool) error {
+	superuser := IsSuperuser(p, f.Opts.SuperuserGroup)
+
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		if !f.canTraverse(p, fileInfos[:len(fileInfos)-1]) || !scopeAllows(p, chainPath(fileInfos), Privileges{Write: true}) {
+			return fmt.Errorf("permission denied for %s", path)
+		}
+
+		fileInfo := fileInfos[len(fileInfos)-1]
+		if !fileInfo.IsDir && (sticky || setGID) {
+			return fmt.Errorf("only directories can be sticky or setgid")
+		}
+
+		targets, err := f.subtree(tx, fileInfo, recursive)
+		if err != nil {
+			return err
+		}
+
+		var dirs []FileInfo
+		for _, target := range targets {
+			if !superuser && target.Permissions.Owner != p.User() {
+				return fmt.Errorf("permission denied, %s is not the owner of '%s'", p.User(), target.Name)
+			}
+			if target.IsDir {
+				dirs = append(dirs, target)
+			}
+		}
+
+		err = tx.Model(&FileInfo{}).Where("id IN ?", fileInfoIDs(targets)).UpdateColumns(map[string]interface{}{
+			"permissions_owner_read":    ownerPermission.Read,
+			"permissions_owner_write":   ownerPermission.Write,
+			"permissions_owner_delete":  ownerPermission.Delete,
+			"permissions_owner_execute": ownerPermission.Execute,
+			"permissions_group_read":    groupPermission.Read,
+			"permissions_group_write":   groupPermission.Write,
+			"permissions_group_delete":  groupPermission.Delete,
+			"permissions_group_execute": groupPermission.Execute,
+			"permissions_other_read":    otherPermission.Read,
+			"permissions_other_write":   otherPermission.Write,
+			"permissions_other_delete":  otherPermission.Delete,
+			"permissions_other_execute": otherPermission.Execute,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update permissions: %w", err)
+		}
+
+		if len(dirs) > 0 {
+			err = tx.Model(&FileInfo{}).Where("id IN ?", fileInfoIDs(dirs)).UpdateColumns(map[string]interface{}{
+				"permissions_sticky": sticky,
+				"permissions_setgid": setGID,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("failed to update flags: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set permission of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// SetOwner changes the owner and the group of path, and if recursive of
+// everything below it. An empty owner or group is left unchanged. Only
+// superusers may change the owner. Owners may change the group to one of
+// their own groups. Delegated tokens need write access in their scope.
+func (f *fileService) SetOwner(p Principal, path string, owner string, group string, recursive bool) error {
+	owner = strings.TrimSpace(owner)
+	group = strings.TrimSpace(group)
+	if owner == "" && group == "" {
+		return fmt.Errorf("failed to set owner of %s: neither owner nor group given", path)
+	}
+
+	superuser := IsSuperuser(p, f.Opts.SuperuserGroup)
+	if owner != "" && !superuser {
+		return fmt.Errorf("failed to set owner of %s: only superusers may change the owner", path)
+	}
+	if group != "" && !superuser && !slices.Contains(p.Groups(), group) {
+		return fmt.Errorf("failed to set owner of %s: user %s is not a member of group %s", path, p.User(), group)
+	}
+
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		if !f.canTraverse(p, fileInfos[:len(fileInfos)-1]) || !scopeAllows(p, chainPath(fileInfos), Privileges{Write: true}) {
+			return fmt.Errorf("permission denied for %s", path)
+		}
+
+		targets, err := f.subtree(tx, fileInfos[len(fileInfos)-1], recursive)
+		if err != nil {
+			return err
+		}
+
+		for _, target := range targets {
+			if !superuser && target.Permissions.Owner != p.User() {
+				return fmt.Errorf("permission denied, %s is not the owner of '%s'", p.User(), target.Name)
+			}
+		}
+
+		if owner != "" {
+			err = f.transferSpace(tx, targets, owner)
+			if err != nil {
+				return err
+			}
+		}
+
+		updates := map[string]interface{}{}
+		if owner != "" {
+			updates["permissions_owner"] = owner
+		}
+		if group != "" {
+			updates["permissions_group"] = group
+		}
+
+		err = tx.Model(&FileInfo{}).Where("id IN ?", fileInfoIDs(targets)).UpdateColumns(updates).Error
+		if err != nil {
+			return fmt.Errorf("failed to update owner: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set owner of %s: %w", path, err)
+	}
+
+	return nil
+}
+
 func (f *fileService) GetBlockInfos(p Principal, path string) ([]BlockInfo, error) {
 	var blockInfos []BlockInfo
 	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
@@ -617,7 +1022,7 @@ func (f *fileService) GetBlockInfos(p Principal, path string) ([]BlockInfo, erro
 			return fmt.Errorf("failed to lookup file: %w", err)
 		}
 
-		if !f.canRead(p, fileInfos...) {
+		if !f.canRead(p, fileInfos) {
 			return fmt.Errorf("permission denied")
 		}
 
@@ -655,7 +1060,7 @@ func (f *fileService) NotifyBlockPresent(n *proto.NotifyBlockPresentRequest) err
 			return fmt.Errorf("path is a directory")
 		}
 
-		err = tx.Where(
+		result := tx.Where(
 			&BlockInfo{ID: n.GetBlockId()}).
 			Attrs(&BlockInfo{
 				ID:         n.GetBlockId(),
@@ -663,9 +1068,16 @@ func (f *fileService) NotifyBlockPresent(n *proto.NotifyBlockPresentRequest) err
 				Sequence:   n.GetSequence(),
 				Length:     n.GetLength(),
 				CRC:        n.GetCrc(),
-			}).FirstOrCreate(&blockInfo).Error
-		if err != nil {
-			return fmt.Errorf("could not get or create block: %w", err)
+			}).FirstOrCreate(&blockInfo)
+		if result.Error != nil {
+			return fmt.Errorf("could not get or create block: %w", result.Error)
+		}
+
+		if result.RowsAffected > 0 {
+			err = f.chargeBlock(tx, fileInfo, blockInfo)
+			if err != nil {
+				return err
+			}
 		}
 
 		if blockInfo.Sequence != n.GetSequence() {
@@ -725,7 +1137,7 @@ func (f *fileService) NotifyBlockAdded(n *proto.NotifyBlockAddedRequest) error {
 			return fmt.Errorf("path is a directory")
 		}
 
-		err = tx.Where(
+		result := tx.Where(
 			&BlockInfo{ID: n.GetBlockId()}).
 			Attrs(&BlockInfo{
 				ID:         n.GetBlockId(),
@@ -733,9 +1145,16 @@ func (f *fileService) NotifyBlockAdded(n *proto.NotifyBlockAddedRequest) error {
 				Sequence:   n.GetSequence(),
 				Length:     n.GetLength(),
 				CRC:        n.GetCrc(),
-			}).FirstOrCreate(&blockInfo).Error
-		if err != nil {
-			return fmt.Errorf("could not get or create block: %w", err)
+			}).FirstOrCreate(&blockInfo)
+		if result.Error != nil {
+			return fmt.Errorf("could not get or create block: %w", result.Error)
+		}
+
+		if result.RowsAffected > 0 {
+			err = f.chargeBlock(tx, fileInfo, blockInfo)
+			if err != nil {
+				return err
+			}
 		}
 
 		if blockInfo.Sequence != n.GetSequence() {
@@ -20,11 +20,16 @@ func createDB(t *testing.T) *gorm.DB {
 		name.User{},
 		name.Group{},
 		name.Token{},
+		name.APIKey{},
+		name.PasswordHistory{},
 		name.Permissions{},
 		name.FileInfo{},
 		name.Permission{},
 		name.BlockInfo{},
-		name.Location{})
+		name.Location{},
+		name.ACLEntry{},
+		name.UserQuota{},
+		name.BlockReservation{})
 	assert.NoError(t, err)
 
 	return db
@@ -95,7 +100,7 @@ func TestFileService_CreateFile(t *testing.T) {
 	assert.True(t, rootFi.IsDir)
 	assert.Nil(t, rootFi.ParentID)
 
-	fi, err := service.CreateFile(p, "/hello.txt", perms)
+	fi, err := service.CreateFile(p, "/hello.txt", &perms)
 	assert.NoError(t, err)
 	assert.Equal(t, "hello.txt", fi.Name)
 	assert.Equal(t, perms, fi.Permissions)
@@ -165,3 +170,299 @@ func TestFileService_NodeRemoved(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, blockInfo.Locations, 0)
 }
+
+func TestFileService_CreateFileOwnership(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	root := name.NewRootPrincipal()
+	_, err = service.CreateDir(root, "/shared", &name.Permissions{
+		Owner:           "root",
+		Group:           "staff",
+		OwnerPermission: name.Permission{Read: true, Write: true, Delete: true, Execute: true},
+		GroupPermission: name.Permission{Read: true, Write: true, Delete: true, Execute: true},
+	})
+	assert.NoError(t, err)
+
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}, {Name: "dev"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "dev"}, {Name: "staff"}}})
+	admin := name.NewPrincipal(name.User{Name: "admin", Groups: []*name.Group{{Name: name.DefaultSuperuserGroup}, {Name: "staff"}}})
+
+	fi, err := service.CreateFile(alice, "/shared/a.txt", &name.Permissions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", fi.Permissions.Owner)
+	assert.Equal(t, "staff", fi.Permissions.Group)
+
+	fi, err = service.CreateFile(alice, "/shared/b.txt", &name.Permissions{Group: "dev"})
+	assert.NoError(t, err)
+	assert.Equal(t, "dev", fi.Permissions.Group)
+
+	_, err = service.CreateFile(alice, "/shared/c.txt", &name.Permissions{Owner: "root"})
+	assert.Error(t, err)
+
+	_, err = service.CreateFile(alice, "/shared/c.txt", &name.Permissions{Group: "root"})
+	assert.Error(t, err)
+
+	fi, err = service.CreateDir(bob, "/shared/bob", &name.Permissions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", fi.Permissions.Owner)
+	assert.Equal(t, "staff", fi.Permissions.Group)
+
+	fi, err = service.CreateFile(admin, "/shared/c.txt", &name.Permissions{Owner: "alice", Group: "ops"})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", fi.Permissions.Owner)
+	assert.Equal(t, "ops", fi.Permissions.Group)
+}
+
+func TestFileService_CreateFilePrimaryGroup(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	carol := name.NewPrincipal(name.User{Name: "carol", Groups: []*name.Group{{Name: "dev"}}})
+
+	fi, err := service.CreateDir(carol, "/carol", &name.Permissions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "carol", fi.Permissions.Owner)
+	assert.Equal(t, "dev", fi.Permissions.Group)
+}
+
+func TestFileService_SetPermission(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "staff"}}})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+
+	_, err = service.CreateDir(alice, "/project", &name.Permissions{OwnerPermission: all, GroupPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateDir(alice, "/project/docs", &name.Permissions{OwnerPermission: all, GroupPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateFile(alice, "/project/docs/readme.txt", &name.Permissions{OwnerPermission: all, GroupPermission: all})
+	assert.NoError(t, err)
+
+	readOnly := name.Permission{Read: true, Execute: true}
+	err = service.SetPermission(bob, "/project", all, readOnly, name.Permission{}, false, false, false)
+	assert.Error(t, err)
+
+	err = service.SetPermission(alice, "/project", all, readOnly, name.Permission{}, false, false, true)
+	assert.NoError(t, err)
+
+	root := name.NewRootPrincipal()
+	for _, path := range []string{"/project", "/project/docs", "/project/docs/readme.txt"} {
+		fi, err := service.Stat(root, path)
+		assert.NoError(t, err)
+		assert.Equal(t, all, fi.Permissions.OwnerPermission, path)
+		assert.Equal(t, readOnly, fi.Permissions.GroupPermission, path)
+	}
+
+	// bob's file makes the recursive change fail as a whole
+	err = service.SetPermission(alice, "/project/docs", all, all, name.Permission{}, false, false, false)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(bob, "/project/docs/bob.txt", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+
+	err = service.SetPermission(alice, "/project", all, name.Permission{}, name.Permission{}, false, false, true)
+	assert.Error(t, err)
+
+	fi, err := service.Stat(root, "/project")
+	assert.NoError(t, err)
+	assert.Equal(t, readOnly, fi.Permissions.GroupPermission)
+}
+
+func TestFileService_SetOwner(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}, {Name: "dev"}}})
+	admin := name.NewPrincipal(name.User{Name: "admin", Groups: []*name.Group{{Name: name.DefaultSuperuserGroup}}})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+
+	_, err = service.CreateDir(alice, "/project", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateFile(alice, "/project/a.txt", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+
+	err = service.SetOwner(alice, "/project", "bob", "", false)
+	assert.Error(t, err)
+
+	err = service.SetOwner(alice, "/project", "", "ops", false)
+	assert.Error(t, err)
+
+	err = service.SetOwner(alice, "/project", "", "dev", true)
+	assert.NoError(t, err)
+
+	root := name.NewRootPrincipal()
+	fi, err := service.Stat(root, "/project/a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", fi.Permissions.Owner)
+	assert.Equal(t, "dev", fi.Permissions.Group)
+
+	err = service.SetOwner(admin, "/project", "bob", "ops", true)
+	assert.NoError(t, err)
+
+	fi, err = service.Stat(root, "/project/a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", fi.Permissions.Owner)
+	assert.Equal(t, "ops", fi.Permissions.Group)
+
+	err = service.SetOwner(alice, "/project", "", "dev", false)
+	assert.Error(t, err)
+}
+
+func TestFileService_Traverse(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	root := name.NewRootPrincipal()
+	alice := name.NewPrincipal(name.User{Name: "alice"})
+	bob := name.NewPrincipal(name.User{Name: "bob"})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+	read := name.Permission{Read: true}
+	traverse := name.Permission{Execute: true}
+
+	_, err = service.CreateDir(root, "/a", &name.Permissions{Owner: "alice", OwnerPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateDir(root, "/a/b", &name.Permissions{Owner: "alice", OwnerPermission: all, OtherPermission: name.Permission{Read: true, Execute: true}})
+	assert.NoError(t, err)
+	_, err = service.CreateFile(root, "/a/b/f.txt", &name.Permissions{Owner: "alice", OwnerPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateFile(root, "/a/open.txt", &name.Permissions{Owner: "alice", OwnerPermission: all, OtherPermission: all})
+	assert.NoError(t, err)
+
+	// permissions of /a/b do not make up for /a
+	_, err = service.Stat(bob, "/a/b")
+	assert.Error(t, err)
+	_, err = service.List(bob, "/a/b")
+	assert.Error(t, err)
+
+	err = service.SetPermission(alice, "/a", all, name.Permission{}, traverse, false, false, false)
+	assert.NoError(t, err)
+
+	// traversing /a is enough to reach /a/b, but not to list /a
+	_, err = service.Stat(bob, "/a/b")
+	assert.NoError(t, err)
+	_, err = service.List(bob, "/a/b")
+	assert.NoError(t, err)
+	_, err = service.List(bob, "/a")
+	assert.Error(t, err)
+	_, err = service.Stat(bob, "/a/b/f.txt")
+	assert.Error(t, err)
+
+	// everything of /a/open.txt is allowed, but /a is not writable
+	_, err = service.Stat(bob, "/a/open.txt")
+	assert.NoError(t, err)
+	err = service.DeleteFile(bob, "/a/open.txt")
+	assert.Error(t, err)
+	_, err = service.CreateFile(bob, "/a/bob.txt", &name.Permissions{})
+	assert.Error(t, err)
+
+	// writing /a/b is enough to create and delete its entries
+	err = service.SetPermission(alice, "/a/b", all, name.Permission{}, all, false, false, false)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(bob, "/a/b/bob.txt", &name.Permissions{})
+	assert.NoError(t, err)
+	err = service.DeleteFile(bob, "/a/b/f.txt")
+	assert.NoError(t, err)
+
+	// without traversing /a nothing below it can be changed
+	err = service.SetPermission(alice, "/a", all, name.Permission{}, read, false, false, false)
+	assert.NoError(t, err)
+	err = service.DeleteFile(bob, "/a/b/bob.txt")
+	assert.Error(t, err)
+	_, err = service.CreateDir(bob, "/a/b/dir", &name.Permissions{})
+	assert.Error(t, err)
+	_, err = service.List(bob, "/a")
+	assert.NoError(t, err)
+
+	_, err = service.Stat(root, "/a/open.txt/b")
+	assert.Error(t, err)
+	err = service.DeleteDir(root, "/")
+	assert.Error(t, err)
+}
+
+func TestFileService_StickyAndSetGID(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+	})
+	assert.NoError(t, err)
+
+	root := name.NewRootPrincipal()
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "users"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "users"}}})
+	carol := name.NewPrincipal(name.User{Name: "carol", Groups: []*name.Group{{Name: "users"}}})
+	admin := name.NewPrincipal(name.User{Name: "admin", Groups: []*name.Group{{Name: name.DefaultSuperuserGroup}}})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+
+	_, err = service.CreateDir(root, "/tmp", &name.Permissions{Owner: "carol", Group: "users", OwnerPermission: all, OtherPermission: all, Sticky: true})
+	assert.NoError(t, err)
+
+	for _, path := range []string{"/tmp/a.txt", "/tmp/b.txt", "/tmp/c.txt"} {
+		_, err = service.CreateFile(alice, path, &name.Permissions{OwnerPermission: all})
+		assert.NoError(t, err)
+	}
+	_, err = service.CreateDir(alice, "/tmp/dir", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+
+	// others may write /tmp but not delete entries of alice
+	err = service.DeleteFile(bob, "/tmp/a.txt")
+	assert.Error(t, err)
+	err = service.DeleteDir(bob, "/tmp/dir")
+	assert.Error(t, err)
+	err = service.DeleteFile(alice, "/tmp/a.txt")
+	assert.NoError(t, err)
+	err = service.DeleteFile(carol, "/tmp/b.txt")
+	assert.NoError(t, err)
+	err = service.DeleteFile(admin, "/tmp/c.txt")
+	assert.NoError(t, err)
+
+	_, err = service.CreateFile(alice, "/tmp/sticky.txt", &name.Permissions{Sticky: true})
+	assert.Error(t, err)
+
+	// setgid makes new entries inherit the group, new directories the flag too
+	_, err = service.CreateDir(root, "/project", &name.Permissions{Owner: "alice", Group: "project", OwnerPermission: all})
+	assert.NoError(t, err)
+	err = service.SetPermission(alice, "/project", all, all, name.Permission{}, false, true, false)
+	assert.NoError(t, err)
+
+	fi, err := service.CreateDir(alice, "/project/docs", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+	assert.Equal(t, "project", fi.Permissions.Group)
+	assert.True(t, fi.Permissions.SetGID)
+
+	fi, err = service.CreateFile(alice, "/project/docs/readme.txt", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+	assert.Equal(t, "project", fi.Permissions.Group)
+	assert.False(t, fi.Permissions.SetGID)
+
+	err = service.SetPermission(alice, "/project/docs/readme.txt", all, all, name.Permission{}, true, false, false)
+	assert.Error(t, err)
+
+	err = service.SetPermission(alice, "/project", all, all, name.Permission{}, true, false, true)
+	assert.NoError(t, err)
+
+	fi, err = service.Stat(root, "/project/docs")
+	assert.NoError(t, err)
+	assert.True(t, fi.Permissions.Sticky)
+	assert.False(t, fi.Permissions.SetGID)
+
+	fi, err = service.Stat(root, "/project/docs/readme.txt")
+	assert.NoError(t, err)
+	assert.False(t, fi.Permissions.Sticky)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/sirupsen/logrus"
 	"math/rand"
@@ -18,6 +19,9 @@ type HealingOpts struct {
 	FileService       FileService
 	NodeExpiration    time.Duration
 	ConnectionFactory proto.ConnectionFactory
+	// BlockKeys signs the access tokens sent with block copies.
+	BlockKeys            *blocktoken.Keyring
+	BlockTokenExpiration time.Duration
 }
 
 func (o *HealingOpts) Validate() error {
@@ -41,17 +45,23 @@ func (o *HealingOpts) Validate() error {
 		return fmt.Errorf("connection factory is required")
 	}
 
+	if o.BlockKeys == nil {
+		return fmt.Errorf("block keys are required")
+	}
+
 	return nil
 }
 
 type HealingService interface {
 	NotifyNodeAlive(host string, at time.Time)
+	NotifyNodeFull(host string, full bool)
 	Heal(since time.Time) error
 }
 
 type healingService struct {
 	Opts  HealingOpts
 	Nodes map[string]time.Time
+	Full  map[string]bool
 	Lock  sync.RWMutex
 }
 
@@ -63,9 +73,14 @@ func NewHealingService(opts HealingOpts) (HealingService, error) {
 		return nil, fmt.Errorf("invalid options: %w", err)
 	}
 
+	if opts.BlockTokenExpiration == 0 {
+		opts.BlockTokenExpiration = DefaultBlockTokenExpiration
+	}
+
 	return &healingService{
 		Opts:  opts,
 		Nodes: map[string]time.Time{},
+		Full:  map[string]bool{},
 		Lock:  sync.RWMutex{},
 	}, nil
 }
@@ -77,6 +92,19 @@ func (s *healingService) NotifyNodeAlive(host string, at time.Time) {
 	s.Nodes[host] = at
 }
 
+// NotifyNodeFull records whether a node has run out of space. Full nodes are
+// not chosen as destinations for new replicas.
+func (s *healingService) NotifyNodeFull(host string, full bool) {
+	s.Lock.Lock()
+	defer s.Lock.Unlock()
+
+	if full {
+		s.Full[host] = true
+	} else {
+		delete(s.Full, host)
+	}
+}
+
 func (s *healingService) Heal(since time.Time) error {
 	removedHosts := s.removeExpiredNodes(since)
 	var allErrors []error
@@ -129,6 +157,7 @@ func (s *healingService) removeExpiredNodes(since time.Time) []string {
 	for _, host := range toRemove {
 		s.Opts.Logger.WithField("host", host).Info("node is dead")
 		delete(s.Nodes, host)
+		delete(s.Full, host)
 	}
 
 	return toRemove
@@ -155,7 +184,7 @@ func (s *healingService) checkBlock(blockInfo BlockInfo, currentLocations []stri
 					continue
 				}
 				source := currentLocations[rand.Intn(len(currentLocations))]
-				go s.copyBlock(blockInfo.ID, source, destination)
+				go s.copyBlock(blockInfo.ID, uint64(blockInfo.Length), source, destination)
 			}
 		}
 	}
@@ -165,6 +194,10 @@ func (s *healingService) findDestinations(currentLocations []string, count int)
 	var candidates []string
 
 	for location := range s.Nodes {
+		if s.Full[location] {
+			continue
+		}
+
 		_, found := slices.BinarySearch(currentLocations, location)
 		if !found {
 			candidates = append(candidates, location)
@@ -180,7 +213,16 @@ func (s *healingService) findDestinations(currentLocations []string, count int)
 	return candidates[:count], true
 }
 
-func (s *healingService) copyBlock(blockId string, source string, dest string) {
+func (s *healingService) copyBlock(blockId string, length uint64, source string, dest string) {
+	// The source passes the token on to the destination, which checks it
+	// against the path stored with the block, so the token is not bound to
+	// a path.
+	accessToken, err := s.Opts.BlockKeys.Issue(blockId, "", blocktoken.OpRead|blocktoken.OpWrite, length, s.Opts.BlockTokenExpiration)
+	if err != nil {
+		s.Opts.Logger.WithError(err).WithField("block-id", blockId).Error("could not issue block access token")
+		return
+	}
+
 	connection, err := s.Opts.ConnectionFactory.CreateConnection(source)
 	if err != nil {
 		s.Opts.Logger.WithError(err).WithField("host", dest).Error("could not create connection")
@@ -198,6 +240,7 @@ func (s *healingService) copyBlock(blockId string, source string, dest string) {
 	_, err = client.CopyBlock(context.Background(), &proto.CopyBlockRequest{
 		Id:          blockId,
 		Destination: dest,
+		AccessToken: accessToken,
 	})
 	if err != nil {
 		s.Opts.Logger.
@@ -1,6 +1,7 @@
 package name_test
 
 import (
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/mocks"
 	"github.com/cirglo.com/dfs/pkg/name"
 	"github.com/sirupsen/logrus"
@@ -19,8 +20,20 @@ func TestNewHealingService(t *testing.T) {
 		FileService:       fileService,
 		NodeExpiration:    24 * time.Hour,
 		ConnectionFactory: connectionFactory,
+		BlockKeys:         blocktoken.NewKeyring(),
 	}
 	service, err := name.NewHealingService(opts)
 	assert.NoError(t, err)
 	assert.NotNil(t, service)
 }
+
+func TestNewHealingService_NoBlockKeys(t *testing.T) {
+	_, err := name.NewHealingService(name.HealingOpts{
+		Logger:            logrus.New(),
+		NumReplicas:       1,
+		FileService:       mocks.NewFileService(t),
+		NodeExpiration:    24 * time.Hour,
+		ConnectionFactory: mocks.NewConnectionFactory(t),
+	})
+	assert.Error(t, err)
+}
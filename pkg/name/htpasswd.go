@@ -0,0 +1,239 @@
+package name
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const HtpasswdProvider = "htpasswd"
+
+type HtpasswdAuthenticatorOpts struct {
+	Logger *logrus.Logger
+	// File holds a user and a password hash per line, separated by a colon.
+	// bcrypt and {SHA} hashes are supported.
+	File string
+	// GroupFile optionally holds a group and its users per line, as in
+	// "group: user1 user2".
+	GroupFile string
+}
+
+func (o *HtpasswdAuthenticatorOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.File == "" {
+		return fmt.Errorf("file is required")
+	}
+
+	return nil
+}
+
+// htpasswdAuthenticator authenticates users listed in a htpasswd file. The
+// files are read again when they changed, so that users can be added without
+// a restart.
+type htpasswdAuthenticator struct {
+	opts     HtpasswdAuthenticatorOpts
+	lock     sync.Mutex
+	hashes   map[string]string
+	groups   map[string][]string
+	modTimes map[string]time.Time
+}
+
+var _ Authenticator = &htpasswdAuthenticator{}
+
+func NewHtpasswdAuthenticator(opts HtpasswdAuthenticatorOpts) (Authenticator, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	a := &htpasswdAuthenticator{opts: opts}
+
+	err = a.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *htpasswdAuthenticator) files() []string {
+	files := []string{a.opts.File}
+	if a.opts.GroupFile != "" {
+		files = append(files, a.opts.GroupFile)
+	}
+
+	return files
+}
+
+func (a *htpasswdAuthenticator) load() error {
+	modTimes := map[string]time.Time{}
+
+	for _, file := range a.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("could not stat %s: %w", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	f, err := os.Open(a.opts.File)
+	if err != nil {
+		return fmt.Errorf("could not open %s: %w", a.opts.File, err)
+	}
+	defer f.Close()
+
+	hashes, err := ParseHtpasswd(f)
+	if err != nil {
+		return fmt.Errorf("could not parse %s: %w", a.opts.File, err)
+	}
+
+	groups := map[string][]string{}
+	if a.opts.GroupFile != "" {
+		g, err := os.Open(a.opts.GroupFile)
+		if err != nil {
+			return fmt.Errorf("could not open %s: %w", a.opts.GroupFile, err)
+		}
+		defer g.Close()
+
+		groups, err = ParseHtgroup(g)
+		if err != nil {
+			return fmt.Errorf("could not parse %s: %w", a.opts.GroupFile, err)
+		}
+	}
+
+	a.hashes = hashes
+	a.groups = groups
+	a.modTimes = modTimes
+
+	return nil
+}
+
+func (a *htpasswdAuthenticator) reloadIfChanged() {
+	for _, file := range a.files() {
+		info, err := os.Stat(file)
+		if err != nil || info.ModTime().Equal(a.modTimes[file]) {
+			continue
+		}
+
+		err = a.load()
+		if err != nil {
+			a.opts.Logger.WithError(err).Warn("Keeping previous htpasswd users")
+		}
+		return
+	}
+}
+
+func (a *htpasswdAuthenticator) Authenticate(credentials Credentials) (User, error) {
+	a.lock.Lock()
+	a.reloadIfChanged()
+	hash, ok := a.hashes[credentials.User]
+	groups := a.groups[credentials.User]
+	a.lock.Unlock()
+
+	if !ok {
+		return User{}, fmt.Errorf("user %s not found in %s: %w", credentials.User, a.opts.File, ErrUnknownUser)
+	}
+
+	err := verifyHtpasswd(hash, credentials.Password)
+	if err != nil {
+		return User{}, fmt.Errorf("invalid password for user %s: %w", credentials.User, err)
+	}
+
+	user := User{Name: credentials.User, Provider: HtpasswdProvider}
+	for _, group := range groups {
+		user.Groups = append(user.Groups, &Group{Name: group})
+	}
+
+	return user, nil
+}
+
+func verifyHtpasswd(hash string, password string) error {
+	switch {
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	case strings.HasPrefix(hash, "{SHA}"):
+		sum := sha1.Sum([]byte(password))
+		expected := base64.StdEncoding.EncodeToString(sum[:])
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(hash, "{SHA}")), []byte(expected)) != 1 {
+			return fmt.Errorf("password does not match")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported hash, use bcrypt")
+	}
+}
+
+// ParseHtpasswd reads lines of a user and a password hash separated by a
+// colon. Empty lines and lines starting with # are skipped.
+func ParseHtpasswd(r io.Reader) (map[string]string, error) {
+	hashes := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		user, hash, ok := strings.Cut(line, ":")
+		if !ok || user == "" || hash == "" {
+			return nil, fmt.Errorf("line %d: expected user:hash", lineNumber)
+		}
+
+		hashes[user] = hash
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("could not read users: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// ParseHtgroup reads lines of a group and its users, as in
+// "group: user1 user2", and returns the groups of each user.
+func ParseHtgroup(r io.Reader) (map[string][]string, error) {
+	groups := map[string][]string{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		group, users, ok := strings.Cut(line, ":")
+		group = strings.TrimSpace(group)
+		if !ok || group == "" {
+			return nil, fmt.Errorf("line %d: expected group: user...", lineNumber)
+		}
+
+		for _, user := range strings.Fields(users) {
+			groups[user] = append(groups[user], group)
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("could not read groups: %w", err)
+	}
+
+	return groups, nil
+}
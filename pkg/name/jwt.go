@@ -0,0 +1,449 @@
+package name
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	JWTProvider = "jwt"
+
+	DefaultJWTUserClaim   = "sub"
+	DefaultJWTGroupsClaim = "groups"
+	DefaultJWTLeeway      = time.Minute
+)
+
+type JWTAuthenticatorOpts struct {
+	Logger *logrus.Logger
+	// JWKSFile holds the JSON Web Key Set of the identity provider.
+	JWKSFile string
+	// Issuer and Audience must match the iss and aud claims when set.
+	Issuer   string
+	Audience string
+	// UserClaim names the claim holding the user name.
+	UserClaim string
+	// GroupsClaim names the claim holding the groups of the user, either a
+	// list or a space separated string.
+	GroupsClaim string
+	// Leeway is the clock skew tolerated for exp and nbf.
+	Leeway time.Duration
+}
+
+func (o *JWTAuthenticatorOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if o.JWKSFile == "" {
+		return fmt.Errorf("jwks file is required")
+	}
+	if o.Leeway < 0 {
+		return fmt.Errorf("leeway must not be negative")
+	}
+
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwtAuthenticator authenticates users by JWTs, for example OIDC ID tokens,
+// passed as password. The key set is read again when the file changed.
+type jwtAuthenticator struct {
+	opts    JWTAuthenticatorOpts
+	lock    sync.Mutex
+	keys    map[string]crypto.PublicKey
+	modTime time.Time
+}
+
+var _ Authenticator = &jwtAuthenticator{}
+
+func NewJWTAuthenticator(opts JWTAuthenticatorOpts) (Authenticator, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	if opts.UserClaim == "" {
+		opts.UserClaim = DefaultJWTUserClaim
+	}
+	if opts.GroupsClaim == "" {
+		opts.GroupsClaim = DefaultJWTGroupsClaim
+	}
+	if opts.Leeway == 0 {
+		opts.Leeway = DefaultJWTLeeway
+	}
+
+	a := &jwtAuthenticator{opts: opts}
+
+	err = a.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *jwtAuthenticator) load() error {
+	info, err := os.Stat(a.opts.JWKSFile)
+	if err != nil {
+		return fmt.Errorf("could not stat %s: %w", a.opts.JWKSFile, err)
+	}
+
+	data, err := os.ReadFile(a.opts.JWKSFile)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", a.opts.JWKSFile, err)
+	}
+
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("could not parse %s: %w", a.opts.JWKSFile, err)
+	}
+
+	a.keys = keys
+	a.modTime = info.ModTime()
+
+	return nil
+}
+
+// ParseJWKS returns the RSA and EC signing keys of a JSON Web Key Set by key
+// id. Keys of other types or for encryption are skipped.
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	set := jwks{}
+	err := json.Unmarshal(data, &set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key set: %w", err)
+	}
+
+	keys := map[string]crypto.PublicKey{}
+
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		var publicKey crypto.PublicKey
+		switch key.Kty {
+		case "RSA":
+			publicKey, err = parseRSAKey(key)
+		case "EC":
+			publicKey, err = parseECKey(key)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key '%s': %w", key.Kid, err)
+		}
+
+		keys[key.Kid] = publicKey
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing keys found")
+	}
+
+	return keys, nil
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+func parseRSAKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(key.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+
+	e, err := decodeBigInt(key.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("exponent is too large")
+	}
+
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func parseECKey(key jwk) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	var ecdhCurve ecdh.Curve
+	switch key.Crv {
+	case "P-256":
+		curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve '%s'", key.Crv)
+	}
+
+	size := (curve.Params().BitSize + 7) / 8
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil || len(x) != size {
+		return nil, fmt.Errorf("invalid x coordinate")
+	}
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil || len(y) != size {
+		return nil, fmt.Errorf("invalid y coordinate")
+	}
+
+	// Rejects points that are not on the curve
+	_, err = ecdhCurve.NewPublicKey(append(append([]byte{4}, x...), y...))
+	if err != nil {
+		return nil, fmt.Errorf("invalid point: %w", err)
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+func (a *jwtAuthenticator) key(kid string) (crypto.PublicKey, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	info, err := os.Stat(a.opts.JWKSFile)
+	if err == nil && !info.ModTime().Equal(a.modTime) {
+		err = a.load()
+		if err != nil {
+			a.opts.Logger.WithError(err).Warn("Keeping previous JWKS keys")
+		}
+	}
+
+	key, ok := a.keys[kid]
+	if !ok && kid == "" && len(a.keys) == 1 {
+		for _, only := range a.keys {
+			return only, nil
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key '%s'", kid)
+	}
+
+	return key, nil
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed []byte, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm '%s'", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return fmt.Errorf("algorithm '%s' does not match RSA key", alg)
+		}
+		return rsa.VerifyPKCS1v15(key, hash, digest, signature)
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(alg, "ES") || len(signature) != 2*size {
+			return fmt.Errorf("algorithm '%s' does not match EC key", alg)
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func numericClaim(claims map[string]any, name string) (time.Time, bool, error) {
+	value, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	number, ok := value.(float64)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("claim %s is not a number", name)
+	}
+
+	return time.Unix(int64(number), 0), true, nil
+}
+
+func stringsClaim(claims map[string]any, name string) ([]string, error) {
+	switch value := claims[name].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.Fields(value), nil
+	case []any:
+		var values []string
+		for _, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim %s holds a non string value", name)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("claim %s is neither a string nor a list", name)
+	}
+}
+
+func (a *jwtAuthenticator) verify(token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("not a JWT: %w", ErrUnknownUser)
+	}
+
+	headerData, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid header encoding: %w", err)
+	}
+	header := jwtHeader{}
+	err = json.Unmarshal(headerData, &header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	key, err := a.key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyJWTSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, fmt.Errorf("signature verification failed: %w", err)
+	}
+
+	claimsData, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid claims encoding: %w", err)
+	}
+	claims := map[string]any{}
+	err = json.Unmarshal(claimsData, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+
+	now := time.Now()
+	expiresAt, ok, err := numericClaim(claims, "exp")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("token has no expiration")
+	}
+	if now.After(expiresAt.Add(a.opts.Leeway)) {
+		return nil, fmt.Errorf("token is expired")
+	}
+
+	notBefore, ok, err := numericClaim(claims, "nbf")
+	if err != nil {
+		return nil, err
+	}
+	if ok && now.Before(notBefore.Add(-a.opts.Leeway)) {
+		return nil, fmt.Errorf("token is not valid yet")
+	}
+
+	if a.opts.Issuer != "" && claims["iss"] != a.opts.Issuer {
+		return nil, fmt.Errorf("token is not issued by %s", a.opts.Issuer)
+	}
+
+	if a.opts.Audience != "" {
+		audience, err := stringsClaim(claims, "aud")
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(audience, a.opts.Audience) {
+			return nil, fmt.Errorf("token is not issued for %s", a.opts.Audience)
+		}
+	}
+
+	return claims, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(credentials Credentials) (User, error) {
+	claims, err := a.verify(credentials.Password)
+	if err != nil {
+		return User{}, fmt.Errorf("invalid JWT: %w", err)
+	}
+
+	userName, ok := claims[a.opts.UserClaim].(string)
+	if !ok || userName == "" {
+		return User{}, fmt.Errorf("JWT has no claim %s", a.opts.UserClaim)
+	}
+	if credentials.User != "" && credentials.User != userName {
+		return User{}, fmt.Errorf("JWT is issued for user %s, not %s", userName, credentials.User)
+	}
+
+	groups, err := stringsClaim(claims, a.opts.GroupsClaim)
+	if err != nil {
+		return User{}, err
+	}
+
+	user := User{Name: userName, Provider: JWTProvider}
+	for _, group := range groups {
+		user.Groups = append(user.Groups, &Group{Name: group})
+	}
+
+	return user, nil
+}
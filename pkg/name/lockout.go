@@ -0,0 +1,136 @@
+package name
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultLockoutMaxFailures = 5
+	DefaultLockoutDuration    = 15 * time.Minute
+	DefaultLoginBackoffBase   = 1 * time.Second
+	DefaultLoginBackoffMax    = 1 * time.Minute
+)
+
+// ErrLoginThrottled is returned for logins attempted while an account is
+// locked or before the backoff after a failed login passed.
+var ErrLoginThrottled = errors.New("too many failed logins")
+
+// ErrPasswordChangeRequired is returned for logins of users that have to
+// change their password first.
+var ErrPasswordChangeRequired = errors.New("password change required")
+
+// LockoutPolicy slows down and stops guessing passwords.
+type LockoutPolicy struct {
+	// MaxFailures failed logins in a row lock an account for Duration.
+	// Accounts are never locked when 0.
+	MaxFailures int
+	Duration    time.Duration
+	// BackoffBase is the time to wait after the first failed login, which
+	// doubles with every further failure up to BackoffMax. Logins are not
+	// delayed when 0.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+func (p *LockoutPolicy) Validate() error {
+	if p.MaxFailures < 0 {
+		return fmt.Errorf("max failures must not be negative")
+	}
+	if p.MaxFailures > 0 && p.Duration <= 0 {
+		return fmt.Errorf("lockout duration is required")
+	}
+	if p.BackoffBase < 0 {
+		return fmt.Errorf("backoff base must not be negative")
+	}
+	if p.BackoffMax < p.BackoffBase {
+		return fmt.Errorf("backoff max must not be less than backoff base")
+	}
+
+	return nil
+}
+
+// backoff returns how long to wait after failures failed logins in a row.
+func (p LockoutPolicy) backoff(failures int) time.Duration {
+	if p.BackoffBase == 0 || failures == 0 {
+		return 0
+	}
+
+	backoff := p.BackoffBase
+	for i := 1; i < failures && backoff < p.BackoffMax; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, p.BackoffMax)
+}
+
+type loginFailures struct {
+	count int
+	last  time.Time
+}
+
+// LoginLimiter delays logins from a source, such as a client IP, after
+// failed ones. Its state is kept in memory by each name server.
+type LoginLimiter struct {
+	policy  LockoutPolicy
+	lock    sync.Mutex
+	entries map[string]loginFailures
+}
+
+func NewLoginLimiter(policy LockoutPolicy) (*LoginLimiter, error) {
+	err := policy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("policy is invalid: %w", err)
+	}
+
+	return &LoginLimiter{
+		policy:  policy,
+		entries: map[string]loginFailures{},
+	}, nil
+}
+
+// Allow returns ErrLoginThrottled while the backoff of source did not pass.
+func (l *LoginLimiter) Allow(source string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := l.entries[source]
+	if !ok {
+		return nil
+	}
+
+	wait := time.Until(entry.last.Add(l.policy.backoff(entry.count)))
+	if wait > 0 {
+		return fmt.Errorf("login from %s is delayed for %s: %w", source, wait.Round(time.Second), ErrLoginThrottled)
+	}
+
+	return nil
+}
+
+func (l *LoginLimiter) Failure(source string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Drop sources that may log in again once in a while so that the map
+	// does not grow with sources that do not come back.
+	if len(l.entries) > 0 && len(l.entries)%1024 == 0 {
+		now := time.Now()
+		for key, entry := range l.entries {
+			if !now.Before(entry.last.Add(l.policy.backoff(entry.count))) {
+				delete(l.entries, key)
+			}
+		}
+	}
+
+	entry := l.entries[source]
+	l.entries[source] = loginFailures{count: entry.count + 1, last: time.Now()}
+}
+
+func (l *LoginLimiter) Success(source string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.entries, source)
+}
@@ -0,0 +1,111 @@
+package name_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestSecurityService_Lockout(t *testing.T) {
+	service := createPolicySecurityService(t, createSecurityDB(t), name.PasswordPolicy{}, name.LockoutPolicy{
+		MaxFailures: 3,
+		Duration:    time.Hour,
+	})
+	assert.NoError(t, service.CreateUser(name.User{Name: "alice", Password: "secret"}))
+
+	for i := 0; i < 3; i++ {
+		_, err := service.AuthenticateUser("alice", "guess")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, name.ErrLoginThrottled)
+	}
+
+	_, err := service.AuthenticateUser("alice", "secret")
+	assert.ErrorIs(t, err, name.ErrLoginThrottled)
+
+	user, err := service.GetUser("alice")
+	assert.NoError(t, err)
+	assert.NotNil(t, user.LockedUntil)
+
+	assert.NoError(t, service.UnlockUser("alice"))
+	_, err = service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+}
+
+func TestSecurityService_LoginBackoff(t *testing.T) {
+	service := createPolicySecurityService(t, createSecurityDB(t), name.PasswordPolicy{}, name.LockoutPolicy{
+		BackoffBase: time.Hour,
+		BackoffMax:  time.Hour,
+	})
+	assert.NoError(t, service.CreateUser(name.User{Name: "alice", Password: "secret"}))
+
+	_, err := service.AuthenticateUser("alice", "guess")
+	assert.NotErrorIs(t, err, name.ErrLoginThrottled)
+
+	_, err = service.AuthenticateUser("alice", "secret")
+	assert.ErrorIs(t, err, name.ErrLoginThrottled)
+
+	user, err := service.GetUser("alice")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, user.FailedLogins)
+	assert.Nil(t, user.LockedUntil)
+}
+
+func TestLoginLimiter(t *testing.T) {
+	_, err := name.NewLoginLimiter(name.LockoutPolicy{BackoffBase: time.Hour})
+	assert.Error(t, err)
+
+	limiter, err := name.NewLoginLimiter(name.LockoutPolicy{BackoffBase: time.Hour, BackoffMax: time.Hour})
+	assert.NoError(t, err)
+
+	assert.NoError(t, limiter.Allow("10.0.0.1"))
+	limiter.Failure("10.0.0.1")
+	assert.ErrorIs(t, limiter.Allow("10.0.0.1"), name.ErrLoginThrottled)
+	assert.NoError(t, limiter.Allow("10.0.0.2"))
+
+	limiter.Success("10.0.0.1")
+	assert.NoError(t, limiter.Allow("10.0.0.1"))
+}
+
+func TestServer_Login(t *testing.T) {
+	securityService := createPolicySecurityService(t, createSecurityDB(t), name.PasswordPolicy{MinLength: 6}, name.LockoutPolicy{})
+	assert.NoError(t, securityService.CreateUser(name.User{Name: "alice", Password: "secret"}))
+	limiter, err := name.NewLoginLimiter(name.LockoutPolicy{BackoffBase: time.Hour, BackoffMax: time.Hour})
+	assert.NoError(t, err)
+
+	server := name.Server{Opts: name.ServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		LoginLimiter:    limiter,
+	}}
+	from := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 4711}})
+	}
+
+	assert.NoError(t, securityService.ExpirePassword("alice"))
+	_, err = server.Login(from("10.0.0.1"), &proto.LoginRequest{User: "alice", HashedPassword: "secret"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.Login(from("10.0.0.1"), &proto.LoginRequest{User: "alice", HashedPassword: "secret", NewPassword: "short"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := server.Login(from("10.0.0.1"), &proto.LoginRequest{User: "alice", HashedPassword: "secret", NewPassword: "changed"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.GetToken())
+
+	_, err = server.Login(from("10.0.0.2"), &proto.LoginRequest{User: "alice", HashedPassword: "secret"})
+	assert.Error(t, err)
+	_, err = server.Login(from("10.0.0.2"), &proto.LoginRequest{User: "alice", HashedPassword: "changed"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = server.Login(from("10.0.0.1"), &proto.LoginRequest{User: "alice", HashedPassword: "changed"})
+	assert.NoError(t, err)
+}
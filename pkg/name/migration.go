@@ -0,0 +1,73 @@
+package name
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration records a data migration that has been applied to the database.
+type Migration struct {
+	Name      string    `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+type dataMigration struct {
+	name  string
+	apply func(tx *gorm.DB) error
+}
+
+// dataMigrations are applied in order, each one only once.
+var dataMigrations = []dataMigration{
+	{name: "traverse-permissions", apply: migrateTraversePermissions},
+}
+
+// migrateTraversePermissions lets everybody who could read a directory
+// traverse it. Before the execute bit existed, access was granted if any
+// directory of a path allowed it, so reading implied traversing.
+func migrateTraversePermissions(tx *gorm.DB) error {
+	return tx.Model(&FileInfo{}).Where("is_dir = ?", true).UpdateColumns(map[string]interface{}{
+		"permissions_owner_execute": gorm.Expr("permissions_owner_read"),
+		"permissions_group_execute": gorm.Expr("permissions_group_read"),
+		"permissions_other_execute": gorm.Expr("permissions_other_read"),
+	}).Error
+}
+
+// Migrate applies the data migrations that have not been applied to db yet
+// and returns their names. The schema must have been migrated before.
+func Migrate(db *gorm.DB) ([]string, error) {
+	err := db.AutoMigrate(&Migration{})
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate migrations: %w", err)
+	}
+
+	var applied []string
+
+	for _, m := range dataMigrations {
+		err = db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Where("name = ?", m.name).First(&Migration{}).Error
+			if err == nil {
+				return nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			err = m.apply(tx)
+			if err != nil {
+				return err
+			}
+
+			applied = append(applied, m.name)
+
+			return tx.Create(&Migration{Name: m.name}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("could not apply migration %s: %w", m.name, err)
+		}
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,47 @@
+package name_test
+
+import (
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	db := createDB(t)
+	parentID := uint64(1)
+	readWrite := name.Permission{Read: true, Write: true}
+
+	// rows written before the execute bit existed
+	fileInfos := []name.FileInfo{
+		{Name: "", IsDir: true, Permissions: name.Permissions{Owner: "root", Group: "root", OwnerPermission: readWrite, OtherPermission: readWrite}},
+		{Name: "dir", IsDir: true, ParentID: &parentID, Permissions: name.Permissions{Owner: "alice", Group: "staff", GroupPermission: readWrite}},
+		{Name: "file", ParentID: &parentID, Permissions: name.Permissions{Owner: "alice", Group: "staff", OwnerPermission: readWrite}},
+	}
+	assert.NoError(t, db.Create(&fileInfos).Error)
+
+	applied, err := name.Migrate(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"traverse-permissions"}, applied)
+
+	var migrated []name.FileInfo
+	assert.NoError(t, db.Order("id").Find(&migrated).Error)
+	assert.Len(t, migrated, 3)
+	assert.True(t, migrated[0].Permissions.OwnerPermission.Execute)
+	assert.False(t, migrated[0].Permissions.GroupPermission.Execute)
+	assert.True(t, migrated[0].Permissions.OtherPermission.Execute)
+	assert.True(t, migrated[1].Permissions.GroupPermission.Execute)
+	assert.False(t, migrated[1].Permissions.OwnerPermission.Execute)
+	assert.False(t, migrated[2].Permissions.OwnerPermission.Execute)
+
+	// migrations are applied once, later changes are kept
+	assert.NoError(t, db.Model(&name.FileInfo{}).Where("id = ?", 2).UpdateColumn("permissions_group_execute", false).Error)
+
+	applied, err = name.Migrate(db)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	var dir name.FileInfo
+	assert.NoError(t, db.First(&dir, 2).Error)
+	assert.False(t, dir.Permissions.GroupPermission.Execute)
+}
@@ -0,0 +1,322 @@
+package name
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultMaxClockSkew = 5 * time.Minute
+
+type nodeContextKey struct{}
+type nodeKeyContextKey struct{}
+
+// ContextWithNode returns a copy of ctx carrying the host of an authenticated
+// node.
+func ContextWithNode(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, nodeContextKey{}, host)
+}
+
+// ContextWithNodeKey returns a copy of ctx carrying the key a node signed
+// its call with.
+func ContextWithNodeKey(ctx context.Context, key []byte) context.Context {
+	return context.WithValue(ctx, nodeKeyContextKey{}, key)
+}
+
+// NodeKeyFromContext returns the key of the node authenticated by the
+// NodeAuthInterceptor, none when the node was authenticated by its
+// certificate.
+func NodeKeyFromContext(ctx context.Context) ([]byte, bool) {
+	key, ok := ctx.Value(nodeKeyContextKey{}).([]byte)
+	return key, ok
+}
+
+// NodeFromContext returns the host of the node authenticated by the
+// NodeAuthInterceptor.
+func NodeFromContext(ctx context.Context) (string, bool) {
+	host, ok := ctx.Value(nodeContextKey{}).(string)
+	return host, ok
+}
+
+type NodeAuthInterceptorOpts struct {
+	Logger *logrus.Logger
+	// ClusterSecret is used to derive the key of nodes not in NodeKeys.
+	ClusterSecret []byte
+	NodeKeys      map[string][]byte
+	MaxClockSkew  time.Duration
+	// CertificateNodes are the host names, without port, of the nodes whose
+	// unsigned calls are accepted when they present a verified TLS client
+	// certificate issued to their host. Certificates of other hosts, such as
+	// the ones of users, are never taken for nodes.
+	CertificateNodes []string
+}
+
+func (o *NodeAuthInterceptorOpts) Validate() error {
+	if o.Logger == nil {
+		return fmt.Errorf("logger is required")
+	}
+	if len(o.ClusterSecret) == 0 && len(o.NodeKeys) == 0 && len(o.CertificateNodes) == 0 {
+		return fmt.Errorf("cluster secret, node keys or trusted client certificates are required")
+	}
+	if o.MaxClockSkew < 0 {
+		return fmt.Errorf("max clock skew must not be negative")
+	}
+
+	return nil
+}
+
+// NodeAuthInterceptor verifies that calls of the Notification service are
+// signed by a node and that nodes only send notifications about themselves.
+// Signed calls are accepted once, their nonces are remembered as long as
+// their timestamps are valid.
+type NodeAuthInterceptor struct {
+	opts             NodeAuthInterceptorOpts
+	certificateNodes map[string]bool
+
+	lock      sync.Mutex
+	nonces    map[string]time.Time
+	nextPrune time.Time
+}
+
+func NewNodeAuthInterceptor(opts NodeAuthInterceptorOpts) (*NodeAuthInterceptor, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	if opts.MaxClockSkew == 0 {
+		opts.MaxClockSkew = DefaultMaxClockSkew
+	}
+
+	certificateNodes := map[string]bool{}
+	for _, node := range opts.CertificateNodes {
+		certificateNodes[node] = true
+	}
+
+	return &NodeAuthInterceptor{opts: opts, certificateNodes: certificateNodes, nonces: map[string]time.Time{}}, nil
+}
+
+func (a *NodeAuthInterceptor) key(host string) ([]byte, bool) {
+	key, found := a.opts.NodeKeys[host]
+	if found {
+		return key, true
+	}
+
+	if len(a.opts.ClusterSecret) == 0 {
+		return nil, false
+	}
+
+	return proto.DeriveNodeKey(a.opts.ClusterSecret, host), true
+}
+
+// replayed records the nonce of a call signed by host at timestamp and
+// reports whether it was seen before.
+func (a *NodeAuthInterceptor) replayed(host string, nonce string, timestamp int64) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := time.Now()
+	if now.After(a.nextPrune) {
+		for seen, expiresAt := range a.nonces {
+			if now.After(expiresAt) {
+				delete(a.nonces, seen)
+			}
+		}
+		a.nextPrune = now.Add(a.opts.MaxClockSkew)
+	}
+
+	seen := host + "\n" + nonce
+	_, found := a.nonces[seen]
+	if found {
+		return true
+	}
+
+	a.nonces[seen] = time.Unix(timestamp, 0).Add(a.opts.MaxClockSkew)
+
+	return false
+}
+
+func firstValue(md metadata.MD, name string) string {
+	values := md.Get(name)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// certificateHost returns host when it is one of the CertificateNodes and the
+// verified client certificate of the peer was issued to it, by subject
+// alternative name or common name. The port of host is ignored.
+func (a *NodeAuthInterceptor) certificateHost(ctx context.Context, host string) (string, bool) {
+	hostname, _, err := net.SplitHostPort(host)
+	if err != nil {
+		hostname = host
+	}
+
+	if !a.certificateNodes[hostname] {
+		return "", false
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return "", false
+	}
+
+	certificate := tlsInfo.State.VerifiedChains[0][0]
+	if certificate.VerifyHostname(hostname) != nil && certificate.Subject.CommonName != hostname {
+		return "", false
+	}
+
+	return host, true
+}
+
+// authenticate returns the host and the key of the node that signed the call
+// or, for the CertificateNodes, the host the verified client certificate was
+// issued to and no key.
+func (a *NodeAuthInterceptor) authenticate(ctx context.Context, method string, req any) (string, []byte, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	host := firstValue(md, proto.NodeHostHeader)
+	signature := firstValue(md, proto.NodeSignatureHeader)
+	if signature == "" && len(a.certificateNodes) > 0 {
+		request, ok := req.(hasHost)
+		if ok {
+			certified, found := a.certificateHost(ctx, request.GetHost())
+			if found {
+				return certified, nil, nil
+			}
+		}
+	}
+
+	nonce := firstValue(md, proto.NodeNonceHeader)
+	if host == "" || signature == "" || nonce == "" {
+		return "", nil, status.Error(codes.Unauthenticated, "node credentials are missing")
+	}
+
+	timestamp, err := strconv.ParseInt(firstValue(md, proto.NodeTimestampHeader), 10, 64)
+	if err != nil {
+		return "", nil, status.Error(codes.Unauthenticated, "node timestamp is invalid")
+	}
+
+	skew := time.Since(time.Unix(timestamp, 0))
+	if skew > a.opts.MaxClockSkew || -skew > a.opts.MaxClockSkew {
+		return "", nil, status.Errorf(codes.Unauthenticated, "node timestamp is off by %s", skew)
+	}
+
+	key, found := a.key(host)
+	if !found {
+		return "", nil, status.Errorf(codes.Unauthenticated, "node %s is unknown", host)
+	}
+
+	digest, err := proto.DigestNodeRequest(req)
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	expected := proto.SignNodeRequest(key, host, method, timestamp, nonce, digest)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return "", nil, status.Errorf(codes.Unauthenticated, "node signature of %s is invalid", host)
+	}
+
+	// only checked for valid signatures, so that forged calls can not fill
+	// the nonces
+	if a.replayed(host, nonce, timestamp) {
+		return "", nil, status.Errorf(codes.Unauthenticated, "call of node %s was replayed", host)
+	}
+
+	return host, key, nil
+}
+
+type hasHost interface {
+	GetHost() string
+}
+
+func (a *NodeAuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	prefix := "/" + proto.Notification_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, prefix) {
+			return handler(ctx, req)
+		}
+
+		host, key, err := a.authenticate(ctx, info.FullMethod, req)
+		if err != nil {
+			a.opts.Logger.WithError(err).WithField("method", info.FullMethod).Warn("Node authentication failed")
+			return nil, err
+		}
+
+		request, ok := req.(hasHost)
+		if ok && request.GetHost() != host {
+			a.opts.Logger.WithFields(logrus.Fields{
+				"node":   host,
+				"host":   request.GetHost(),
+				"method": info.FullMethod,
+			}).Warn("Node sent notification for another host")
+			return nil, status.Errorf(codes.PermissionDenied, "node %s may not notify for host %s", host, request.GetHost())
+		}
+
+		ctx = ContextWithNode(ctx, host)
+		if key != nil {
+			ctx = ContextWithNodeKey(ctx, key)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ParseNodeKeys reads lines of a host and its hex encoded key. Empty lines and
+// lines starting with # are ignored.
+func ParseNodeKeys(r io.Reader) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected host and key", lineNumber)
+		}
+
+		key, err := hex.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid key: %w", lineNumber, err)
+		}
+
+		keys[fields[0]] = key
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("could not read node keys: %w", err)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,316 @@
+package name_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const notifyBlockAddedMethod = "/notification.Notification/NotifyBlockAdded"
+
+var nonces = 0
+
+func signedContext(key []byte, host string, method string, req any, at time.Time) context.Context {
+	nonces++
+	nonce := strconv.Itoa(nonces)
+
+	return signedContextWithNonce(key, host, method, req, at, nonce)
+}
+
+func signedContextWithNonce(key []byte, host string, method string, req any, at time.Time, nonce string) context.Context {
+	digest, err := proto.DigestNodeRequest(req)
+	if err != nil {
+		panic(err)
+	}
+
+	timestamp := at.Unix()
+	md := metadata.Pairs(
+		proto.NodeHostHeader, host,
+		proto.NodeTimestampHeader, strconv.FormatInt(timestamp, 10),
+		proto.NodeNonceHeader, nonce,
+		proto.NodeSignatureHeader, proto.SignNodeRequest(key, host, method, timestamp, nonce, digest))
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestNodeAuthInterceptor(t *testing.T) {
+	secret := []byte("cluster-secret")
+	interceptor, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{
+		Logger:        logrus.New(),
+		ClusterSecret: secret,
+		NodeKeys:      map[string][]byte{"special:55055": []byte("special-key")},
+	})
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: notifyBlockAddedMethod}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+	key := proto.DeriveNodeKey(secret, "node1:55055")
+	request := &proto.NotifyBlockAddedRequest{Host: "node1:55055"}
+
+	resp, err := interceptor.Unary()(signedContext(key, "node1:55055", notifyBlockAddedMethod, request, time.Now()), request, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor.Unary()(context.Background(), request, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	forged := &proto.NotifyBlockAddedRequest{Host: "node2:55055"}
+	_, err = interceptor.Unary()(signedContext(key, "node1:55055", notifyBlockAddedMethod, forged, time.Now()), forged, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor.Unary()(signedContext(key, "node2:55055", notifyBlockAddedMethod, forged, time.Now()), forged, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor.Unary()(signedContext(key, "node1:55055", notifyBlockAddedMethod, request, time.Now().Add(-time.Hour)), request, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor.Unary()(signedContext(key, "node1:55055", "/notification.Notification/NotifyBlockRemoved", request, time.Now()), request, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	special := &proto.NotifyBlockAddedRequest{Host: "special:55055"}
+	_, err = interceptor.Unary()(signedContext([]byte("special-key"), "special:55055", notifyBlockAddedMethod, special, time.Now()), special, info, handler)
+	assert.NoError(t, err)
+
+	_, err = interceptor.Unary()(signedContext(proto.DeriveNodeKey(secret, "special:55055"), "special:55055", notifyBlockAddedMethod, special, time.Now()), special, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	otherInfo := &grpc.UnaryServerInfo{FullMethod: "/name.Name/Login"}
+	_, err = interceptor.Unary()(context.Background(), &proto.LoginRequest{}, otherInfo, handler)
+	assert.NoError(t, err)
+}
+
+func TestNodeAuthInterceptor_Replay(t *testing.T) {
+	secret := []byte("cluster-secret")
+	interceptor, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{
+		Logger:        logrus.New(),
+		ClusterSecret: secret,
+	})
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: notifyBlockAddedMethod}
+	var nodeKey []byte
+	handler := func(ctx context.Context, req any) (any, error) {
+		nodeKey, _ = name.NodeKeyFromContext(ctx)
+		return "ok", nil
+	}
+	key := proto.DeriveNodeKey(secret, "node1:55055")
+	request := &proto.NotifyBlockAddedRequest{Host: "node1:55055", BlockId: "block-1", Length: 100}
+
+	ctx := signedContextWithNonce(key, "node1:55055", notifyBlockAddedMethod, request, time.Now(), "nonce-1")
+	_, err = interceptor.Unary()(ctx, request, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, key, nodeKey)
+
+	// the same signed call is only accepted once
+	_, err = interceptor.Unary()(ctx, request, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the signature does not hold for another payload
+	tampered := &proto.NotifyBlockAddedRequest{Host: "node1:55055", BlockId: "block-2", Length: 100}
+	ctx = signedContextWithNonce(key, "node1:55055", notifyBlockAddedMethod, request, time.Now(), "nonce-2")
+	_, err = interceptor.Unary()(ctx, tampered, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// a rejected call does not use up its nonce
+	_, err = interceptor.Unary()(ctx, request, info, handler)
+	assert.NoError(t, err)
+
+	ctx = signedContextWithNonce(key, "node1:55055", notifyBlockAddedMethod, request, time.Now(), "")
+	_, err = interceptor.Unary()(ctx, request, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNodeAuthUnaryClientInterceptor(t *testing.T) {
+	secret := []byte("cluster-secret")
+	interceptor, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{
+		Logger:        logrus.New(),
+		ClusterSecret: secret,
+	})
+	assert.NoError(t, err)
+
+	request := &proto.NotifyBlockAddedRequest{Host: "node1:55055", BlockId: "block-1", Path: "/data/f1"}
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	sign := proto.NodeAuthUnaryClientInterceptor("node1:55055", proto.DeriveNodeKey(secret, "node1:55055"))
+	assert.NoError(t, sign(context.Background(), notifyBlockAddedMethod, request, nil, nil, invoker))
+
+	info := &grpc.UnaryServerInfo{FullMethod: notifyBlockAddedMethod}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+	_, err = interceptor.Unary()(metadata.NewIncomingContext(context.Background(), outgoing), request, info, handler)
+	assert.NoError(t, err)
+
+	first := outgoing
+	assert.NoError(t, sign(context.Background(), notifyBlockAddedMethod, request, nil, nil, invoker))
+	assert.NotEqual(t, first.Get(proto.NodeNonceHeader), outgoing.Get(proto.NodeNonceHeader))
+}
+
+func TestNewNodeAuthInterceptor_NoKeys(t *testing.T) {
+	_, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{Logger: logrus.New()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cluster secret, node keys or trusted client certificates are required")
+}
+
+func TestParseNodeKeys(t *testing.T) {
+	keys, err := name.ParseNodeKeys(strings.NewReader("# nodes\nnode1:55055 0a0b\n\nnode2:55055 ff\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"node1:55055": {0x0a, 0x0b},
+		"node2:55055": {0xff},
+	}, keys)
+
+	_, err = name.ParseNodeKeys(strings.NewReader("node1:55055 xyz\n"))
+	assert.Error(t, err)
+
+	_, err = name.ParseNodeKeys(strings.NewReader("node1:55055\n"))
+	assert.Error(t, err)
+}
+
+func TestAdminServer_IssueNodeKey(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	server, err := name.NewAdminServer(name.AdminServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		SuperuserGroup:  name.DefaultSuperuserGroup,
+		ClusterSecret:   []byte("cluster-secret"),
+	})
+	assert.NoError(t, err)
+
+	_, err = name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+	token, err := securityService.AuthenticateUser("admin", "secret")
+	assert.NoError(t, err)
+
+	resp, err := server.IssueNodeKey(context.Background(), &proto.IssueNodeKeyRequest{Token: token, Host: "node1:55055"})
+	assert.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(proto.DeriveNodeKey([]byte("cluster-secret"), "node1:55055")), resp.Key)
+}
+
+func TestAdminServer_IssueNodeAccessToken(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	keys := blocktoken.NewKeyring()
+	_, err := keys.Rotate(time.Hour)
+	assert.NoError(t, err)
+	server, err := name.NewAdminServer(name.AdminServerOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		SuperuserGroup:  name.DefaultSuperuserGroup,
+		BlockKeys:       keys,
+	})
+	assert.NoError(t, err)
+
+	_, err = name.BootstrapAdmin(securityService, "admin", "secret", name.DefaultSuperuserGroup)
+	assert.NoError(t, err)
+	token, err := securityService.AuthenticateUser("admin", "secret")
+	assert.NoError(t, err)
+	assert.NoError(t, securityService.CreateUser(name.User{Name: "alice", Password: "secret"}))
+	aliceToken, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	_, err = server.IssueNodeAccessToken(context.Background(), &proto.IssueNodeAccessTokenRequest{Token: aliceToken, Host: "node1:55055"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := server.IssueNodeAccessToken(context.Background(), &proto.IssueNodeAccessTokenRequest{Token: token, Host: "node1:55055"})
+	assert.NoError(t, err)
+	_, err = keys.Verify(resp.AccessToken, "node1:55055", blocktoken.OpAdmin)
+	assert.NoError(t, err)
+	_, err = keys.Verify(resp.AccessToken, "node1:55055", blocktoken.OpRead)
+	assert.Error(t, err)
+}
+
+func TestNotificationServer_GetBlockKeys(t *testing.T) {
+	keys := blocktoken.NewKeyring()
+	key, err := keys.Rotate(time.Hour)
+	assert.NoError(t, err)
+	server := name.NotificationServer{BlockKeys: keys}
+
+	_, err = server.GetBlockKeys(context.Background(), &proto.GetBlockKeysRequest{Host: "node1:55055"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// nodes authenticated by their certificate get the keys over TLS
+	resp, err := server.GetBlockKeys(name.ContextWithNode(context.Background(), "node1:55055"), &proto.GetBlockKeysRequest{Host: "node1:55055"})
+	assert.NoError(t, err)
+	assert.False(t, resp.Sealed)
+	assert.Len(t, resp.Keys, 1)
+	assert.Equal(t, key.ID, resp.Keys[0].Id)
+	assert.Equal(t, key.Secret, resp.Keys[0].Secret)
+
+	// nodes that signed the call get the keys sealed with their key
+	nodeKey := proto.DeriveNodeKey([]byte("cluster-secret"), "node1:55055")
+	ctx := name.ContextWithNodeKey(name.ContextWithNode(context.Background(), "node1:55055"), nodeKey)
+	resp, err = server.GetBlockKeys(ctx, &proto.GetBlockKeysRequest{Host: "node1:55055"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Sealed)
+	assert.Len(t, resp.Keys, 1)
+	assert.NotEqual(t, key.Secret, resp.Keys[0].Secret)
+
+	secret, err := proto.OpenBlockKey(nodeKey, key.ID, resp.Keys[0].Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, key.Secret, secret)
+
+	_, err = proto.OpenBlockKey(proto.DeriveNodeKey([]byte("cluster-secret"), "node2:55055"), key.ID, resp.Keys[0].Secret)
+	assert.Error(t, err)
+	_, err = proto.OpenBlockKey(nodeKey, key.ID+1, resp.Keys[0].Secret)
+	assert.Error(t, err)
+}
+
+func certificateContext(hosts ...string) context.Context {
+	certificate := &x509.Certificate{DNSNames: hosts}
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{certificate}},
+	}}
+
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: tlsInfo})
+}
+
+func TestNodeAuthInterceptor_ClientCertificate(t *testing.T) {
+	interceptor, err := name.NewNodeAuthInterceptor(name.NodeAuthInterceptorOpts{
+		Logger:           logrus.New(),
+		CertificateNodes: []string{"node1"},
+	})
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: notifyBlockAddedMethod}
+	var authenticated string
+	handler := func(ctx context.Context, req any) (any, error) {
+		authenticated, _ = name.NodeFromContext(ctx)
+		return "ok", nil
+	}
+
+	_, err = interceptor.Unary()(certificateContext("node1"), &proto.NotifyBlockAddedRequest{Host: "node1:55055"}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "node1:55055", authenticated)
+
+	_, err = interceptor.Unary()(certificateContext("node1"), &proto.NotifyBlockAddedRequest{Host: "node2:55055"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor.Unary()(context.Background(), &proto.NotifyBlockAddedRequest{Host: "node1:55055"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// a certificate of the same CA issued to a user is not taken for a node
+	_, err = interceptor.Unary()(certificateContext("user1"), &proto.NotifyBlockAddedRequest{Host: "user1:55055"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
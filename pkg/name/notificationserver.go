@@ -2,7 +2,10 @@ package name
 
 import (
 	"context"
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -10,6 +13,7 @@ type NotificationServer struct {
 	proto.UnimplementedNotificationServer
 	FileService    FileService
 	HealingService HealingService
+	BlockKeys      *blocktoken.Keyring
 }
 
 var _ proto.NotificationServer = (*NotificationServer)(nil)
@@ -23,7 +27,10 @@ func (n NotificationServer) NotifyBlockPresent(ctx context.Context, request *pro
 func (n NotificationServer) NotifyBlockAdded(ctx context.Context, request *proto.NotifyBlockAddedRequest) (*proto.NotifyBlockAddedResponse, error) {
 	n.HealingService.NotifyNodeAlive(request.Host, time.Now())
 	err := n.FileService.NotifyBlockAdded(request)
-	return &proto.NotifyBlockAddedResponse{}, err
+	if err != nil {
+		return nil, quotaError("failed to add block", err)
+	}
+	return &proto.NotifyBlockAddedResponse{}, nil
 }
 
 func (n NotificationServer) NotifyBlockRemoved(ctx context.Context, request *proto.NotifyBlockRemovedRequest) (*proto.NotifyBlockRemovedResponse, error) {
@@ -31,3 +38,47 @@ func (n NotificationServer) NotifyBlockRemoved(ctx context.Context, request *pro
 	err := n.FileService.NotifyBlockRemoved(request)
 	return &proto.NotifyBlockRemovedResponse{}, err
 }
+
+func (n NotificationServer) NotifyNodeStatus(ctx context.Context, request *proto.NotifyNodeStatusRequest) (*proto.NotifyNodeStatusResponse, error) {
+	n.HealingService.NotifyNodeAlive(request.Host, time.Now())
+	n.HealingService.NotifyNodeFull(request.Host, request.Full)
+	return &proto.NotifyNodeStatusResponse{}, nil
+}
+
+// GetBlockKeys hands out the keys signing block access tokens. Only nodes
+// authenticated by the NodeAuthInterceptor get them. The secrets are sealed
+// with the key of nodes that signed the call, as the call may not be
+// encrypted. The CertificateNodes authenticated by their TLS client
+// certificate get them unsealed, the TLS connection protects them.
+func (n NotificationServer) GetBlockKeys(ctx context.Context, request *proto.GetBlockKeysRequest) (*proto.GetBlockKeysResponse, error) {
+	_, ok := NodeFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "block keys are only available to authenticated nodes")
+	}
+
+	if n.BlockKeys == nil {
+		return nil, status.Error(codes.Unavailable, "block access tokens are not configured")
+	}
+
+	nodeKey, sealed := NodeKeyFromContext(ctx)
+	var keys []*proto.BlockKey
+
+	for _, key := range n.BlockKeys.Keys() {
+		secret := key.Secret
+		if sealed {
+			var err error
+			secret, err = proto.SealBlockKey(nodeKey, key.ID, key.Secret)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to seal block key: %v", err)
+			}
+		}
+
+		keys = append(keys, &proto.BlockKey{
+			Id:        key.ID,
+			Secret:    secret,
+			ExpiresAt: key.ExpiresAt.Unix(),
+		})
+	}
+
+	return &proto.GetBlockKeysResponse{Keys: keys, Sealed: sealed}, nil
+}
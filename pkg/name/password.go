@@ -0,0 +1,69 @@
+package name
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	DefaultPasswordIterations = 600000
+
+	passwordSaltLength = 16
+	passwordKeyLength  = 32
+)
+
+// hashPassword derives a PBKDF2-SHA256 key from the password using a fresh
+// random salt. Both the key and the salt are returned base64 encoded.
+func hashPassword(password string, iterations int) (string, string, error) {
+	salt := make([]byte, passwordSaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", "", fmt.Errorf("could not create salt: %w", err)
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, iterations, passwordKeyLength, sha256.New)
+
+	return base64.StdEncoding.EncodeToString(key), base64.StdEncoding.EncodeToString(salt), nil
+}
+
+// verifyPassword compares the password with the stored hash of the user in
+// constant time. Users without an iteration count predate hashing and still
+// have their password stored as is.
+func verifyPassword(user User, password string) bool {
+	if user.Iterations == 0 {
+		return subtle.ConstantTimeCompare([]byte(user.HashedPassword), []byte(password)) == 1
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(user.Salt)
+	if err != nil {
+		return false
+	}
+
+	expected, err := base64.StdEncoding.DecodeString(user.HashedPassword)
+	if err != nil {
+		return false
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, user.Iterations, len(expected), sha256.New)
+
+	return subtle.ConstantTimeCompare(key, expected) == 1
+}
+
+// setPassword replaces the stored password of the user by a salted hash.
+func setPassword(user *User, password string, iterations int) error {
+	hash, salt, err := hashPassword(password, iterations)
+	if err != nil {
+		return err
+	}
+
+	user.HashedPassword = hash
+	user.Salt = salt
+	user.Iterations = iterations
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package name
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPasswordMinLength = 8
+
+	PasswordClassUpper  = "upper"
+	PasswordClassLower  = "lower"
+	PasswordClassDigit  = "digit"
+	PasswordClassSymbol = "symbol"
+)
+
+var passwordClasses = map[string]func(rune) bool{
+	PasswordClassUpper: unicode.IsUpper,
+	PasswordClassLower: unicode.IsLower,
+	PasswordClassDigit: unicode.IsDigit,
+	PasswordClassSymbol: func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r)
+	},
+}
+
+// PasswordPolicy is checked whenever a password is set.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// Classes are the character classes, such as PasswordClassUpper, of
+	// which a password needs at least one character each.
+	Classes []string
+	// History is the number of recent passwords, the current one included,
+	// that may not be used again.
+	History int
+}
+
+func (p *PasswordPolicy) Validate() error {
+	if p.MinLength < 0 {
+		return fmt.Errorf("min length must not be negative")
+	}
+	if p.History < 0 {
+		return fmt.Errorf("history must not be negative")
+	}
+	for _, class := range p.Classes {
+		if _, ok := passwordClasses[class]; !ok {
+			return fmt.Errorf("unknown character class '%s'", class)
+		}
+	}
+
+	return nil
+}
+
+// ParsePasswordClasses parses a comma separated list of character classes.
+func ParsePasswordClasses(value string) ([]string, error) {
+	var classes []string
+
+	for _, class := range strings.Split(value, ",") {
+		class = strings.TrimSpace(class)
+		if class == "" {
+			continue
+		}
+		if _, ok := passwordClasses[class]; !ok {
+			return nil, fmt.Errorf("unknown character class '%s'", class)
+		}
+		classes = append(classes, class)
+	}
+
+	return classes, nil
+}
+
+// Check reports why password does not satisfy the policy, if it does not.
+func (p PasswordPolicy) Check(password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must have at least %d characters", p.MinLength)
+	}
+
+	for _, class := range p.Classes {
+		if !slices.ContainsFunc([]rune(password), passwordClasses[class]) {
+			return fmt.Errorf("password must contain a character of class %s", class)
+		}
+	}
+
+	return nil
+}
+
+// PasswordHistory keeps a former password of a user, hashed like the current
+// one, to prevent its reuse.
+type PasswordHistory struct {
+	ID             uint64    `gorm:"column:id;autoIncrement;primaryKey"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+	UserName       string    `gorm:"column:user_name;not null;index"`
+	HashedPassword string    `gorm:"column:hp;not null"`
+	Salt           string    `gorm:"column:salt;not null;default:''"`
+	Iterations     int       `gorm:"column:iterations;not null;default:0"`
+}
+
+// recentPasswords returns the current password of user followed by the most
+// recent former ones, history passwords in total.
+func recentPasswords(tx *gorm.DB, user User, history int) ([]User, error) {
+	if history == 0 {
+		return nil, nil
+	}
+
+	passwords := []User{user}
+	if history == 1 {
+		return passwords, nil
+	}
+
+	var entries []PasswordHistory
+	err := tx.Where("user_name = ?", user.Name).Order("id DESC").Limit(history - 1).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password history of user %s: %w", user.Name, err)
+	}
+
+	for _, entry := range entries {
+		passwords = append(passwords, User{
+			HashedPassword: entry.HashedPassword,
+			Salt:           entry.Salt,
+			Iterations:     entry.Iterations,
+		})
+	}
+
+	return passwords, nil
+}
+
+// rememberPassword moves the current password of user into the history and
+// drops the entries beyond what the policy keeps.
+func rememberPassword(tx *gorm.DB, user User, history int) error {
+	if history <= 1 || user.HashedPassword == "" {
+		return nil
+	}
+
+	err := tx.Create(&PasswordHistory{
+		UserName:       user.Name,
+		HashedPassword: user.HashedPassword,
+		Salt:           user.Salt,
+		Iterations:     user.Iterations,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to remember password of user %s: %w", user.Name, err)
+	}
+
+	var keep []uint64
+	err = tx.Model(&PasswordHistory{}).Where("user_name = ?", user.Name).Order("id DESC").Limit(history-1).Pluck("id", &keep).Error
+	if err != nil {
+		return fmt.Errorf("failed to get password history of user %s: %w", user.Name, err)
+	}
+
+	err = tx.Where("user_name = ? AND id NOT IN ?", user.Name, keep).Delete(&PasswordHistory{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to trim password history of user %s: %w", user.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package name_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createPolicySecurityService(t *testing.T, db *gorm.DB, policy name.PasswordPolicy, lockout name.LockoutPolicy) name.SecurityService {
+	service, err := name.NewSecurityService(name.SecurityServiceOpts{
+		Logger:             logrus.New(),
+		DB:                 db,
+		TokenExperiation:   1 * time.Hour,
+		PasswordIterations: 1000,
+		PasswordPolicy:     policy,
+		Lockout:            lockout,
+	})
+	assert.NoError(t, err)
+
+	return service
+}
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	policy := name.PasswordPolicy{
+		MinLength: 8,
+		Classes:   []string{name.PasswordClassUpper, name.PasswordClassDigit, name.PasswordClassSymbol},
+	}
+	assert.NoError(t, policy.Validate())
+
+	assert.NoError(t, policy.Check("Secret-42"))
+	assert.Error(t, policy.Check("Se-42"))
+	assert.Error(t, policy.Check("secret-42"))
+	assert.Error(t, policy.Check("Secret-xy"))
+	assert.Error(t, policy.Check("Secret420"))
+
+	_, err := name.ParsePasswordClasses("upper, digit")
+	assert.NoError(t, err)
+	_, err = name.ParsePasswordClasses("upper,emoji")
+	assert.Error(t, err)
+}
+
+func TestSecurityService_PasswordPolicy(t *testing.T) {
+	service := createPolicySecurityService(t, createSecurityDB(t), name.PasswordPolicy{MinLength: 6, History: 3}, name.LockoutPolicy{})
+
+	assert.Error(t, service.CreateUser(name.User{Name: "alice", Password: "short"}))
+	assert.NoError(t, service.CreateUser(name.User{Name: "alice", Password: "first-password"}))
+
+	assert.Error(t, service.ChangeUserPassword("alice", "tiny"))
+	assert.Error(t, service.ChangeUserPassword("alice", "first-password"))
+	assert.NoError(t, service.ChangeUserPassword("alice", "second-password"))
+	assert.NoError(t, service.ChangeUserPassword("alice", "third-password"))
+	assert.Error(t, service.ChangeUserPassword("alice", "first-password"))
+	assert.NoError(t, service.ChangeUserPassword("alice", "fourth-password"))
+
+	// first-password dropped out of the history of three
+	assert.NoError(t, service.ChangeUserPassword("alice", "first-password"))
+
+	_, err := service.AuthenticateUser("alice", "first-password")
+	assert.NoError(t, err)
+}
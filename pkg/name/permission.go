@@ -10,10 +10,13 @@ type HasPermissions interface {
 	GetPermissions() Permissions
 }
 
+// Permission holds the bits of one class of users. Execute allows to traverse
+// a directory, it has no meaning for files.
 type Permission struct {
-	Read   bool `gorm:"not null"`
-	Write  bool `gorm:"not null"`
-	Delete bool `gorm:"not null"`
+	Read    bool `gorm:"not null"`
+	Write   bool `gorm:"not null"`
+	Delete  bool `gorm:"not null"`
+	Execute bool `gorm:"not null;default:false"`
 }
 
 type Permissions struct {
@@ -22,6 +25,12 @@ type Permissions struct {
 	OwnerPermission Permission `gorm:"embedded;embeddedPrefix:owner_"`
 	GroupPermission Permission `gorm:"embedded;embeddedPrefix:group_"`
 	OtherPermission Permission `gorm:"embedded;embeddedPrefix:other_"`
+	// Sticky restricts deleting entries of a directory to the owner of the
+	// entry, the owner of the directory and superusers.
+	Sticky bool `gorm:"not null;default:false"`
+	// SetGID makes new entries of a directory inherit its group, and new
+	// directories also this flag.
+	SetGID bool `gorm:"column:setgid;not null;default:false"`
 }
 
 func (p *Permissions) BeforeSave(_ *gorm.DB) error {
@@ -1,12 +1,27 @@
 package name
 
+import "slices"
+
 type Principal interface {
 	ComputePrivileges(hasPermissionsList ...HasPermissions) Privileges
+	// User returns the name of the user.
+	User() string
+	// Groups returns the groups of the user. The first one is the primary
+	// group.
+	Groups() []string
+	// Umask returns the umask of the user, empty to use the one of the
+	// server.
+	Umask() string
+	// Scope returns the restrictions of the delegated token the principal
+	// authenticated with, nil if it is not restricted.
+	Scope() *TokenScope
 }
 
 type principal struct {
 	user   string
 	groups []string
+	umask  string
+	scope  *TokenScope
 }
 
 func (p *principal) User() string {
@@ -17,6 +32,14 @@ func (p *principal) Groups() []string {
 	return p.groups
 }
 
+func (p *principal) Umask() string {
+	return p.umask
+}
+
+func (p *principal) Scope() *TokenScope {
+	return p.scope
+}
+
 func NewPrincipal(user User) Principal {
 	var groups []string
 
@@ -27,81 +50,79 @@ func NewPrincipal(user User) Principal {
 	return &principal{
 		user:   user.Name,
 		groups: groups,
+		umask:  user.Umask,
+		scope:  user.Scope,
 	}
 }
 
-func (p principal) ComputePrivileges(hasPermissionList ...HasPermissions) Privileges {
-	canRead := false
-	canWrite := false
-	canDelete := false
+func grant(privileges *Privileges, permission Permission) {
+	privileges.Read = privileges.Read || permission.Read
+	privileges.Write = privileges.Write || permission.Write
+	privileges.Delete = privileges.Delete || permission.Delete
+	privileges.Execute = privileges.Execute || permission.Execute
+}
 
-	for _, hasPermissions := range hasPermissionList {
-		permissions := hasPermissions.GetPermissions()
+// privilegesOf returns what the permissions and the ACL, if any, grant. Named
+// ACL entries and the group of the file are limited by the mask, the owner
+// and others are not.
+func (p principal) privilegesOf(hasPermissions HasPermissions) Privileges {
+	permissions := hasPermissions.GetPermissions()
+	privileges := Privileges{}
+	groupClass := Privileges{}
 
-		if permissions.OtherPermission.Read {
-			canRead = true
-		}
+	grant(&privileges, permissions.OtherPermission)
 
-		if permissions.OtherPermission.Write {
-			canWrite = true
-		}
+	if permissions.Owner == p.user {
+		grant(&privileges, permissions.OwnerPermission)
+	}
 
-		if permissions.OtherPermission.Delete {
-			canDelete = true
-		}
+	if slices.Contains(p.groups, permissions.Group) {
+		grant(&groupClass, permissions.GroupPermission)
+	}
 
-		if permissions.Owner == p.user {
-			if permissions.OwnerPermission.Read {
-				canRead = true
-			}
+	if hasACL, ok := hasPermissions.(HasACL); ok {
+		mask := Privileges{Read: true, Write: true, Delete: true, Execute: true}
 
-			if permissions.OwnerPermission.Write {
-				canWrite = true
+		for _, entry := range hasACL.GetACL() {
+			if entry.Default {
+				continue
 			}
 
-			if permissions.OwnerPermission.Delete {
-				canDelete = true
+			switch entry.Type {
+			case ACLTypeUser:
+				if entry.Name == p.user {
+					grant(&groupClass, entry.Permission)
+				}
+			case ACLTypeGroup:
+				if slices.Contains(p.groups, entry.Name) {
+					grant(&groupClass, entry.Permission)
+				}
+			case ACLTypeMask:
+				mask = Privileges{}
+				grant(&mask, entry.Permission)
 			}
 		}
 
-		for _, group := range p.groups {
-			if permissions.Group == group {
-				if permissions.GroupPermission.Read {
-					canRead = true
-				}
+		groupClass = groupClass.Union(mask)
+	}
 
-				if permissions.GroupPermission.Write {
-					canWrite = true
-				}
+	grant(&privileges, Permission(groupClass))
 
-				if permissions.GroupPermission.Delete {
-					canDelete = true
-				}
+	return privileges
+}
 
-				if canRead && canWrite && canDelete {
-					return Privileges{
-						Read:   true,
-						Write:  true,
-						Delete: true,
-					}
-				}
-			}
-		}
+func (p principal) ComputePrivileges(hasPermissionList ...HasPermissions) Privileges {
+	privileges := Privileges{}
 
-		if canRead && canWrite && canDelete {
-			return Privileges{
-				Read:   true,
-				Write:  true,
-				Delete: true,
-			}
+	for _, hasPermissions := range hasPermissionList {
+		grant(&privileges, Permission(p.privilegesOf(hasPermissions)))
+
+		if privileges.Read && privileges.Write && privileges.Delete && privileges.Execute {
+			return privileges
 		}
 	}
 
-	return Privileges{
-		Read:   canRead,
-		Write:  canWrite,
-		Delete: canDelete,
-	}
+	return privileges
 }
 
 type rootPrincipal struct {
@@ -111,10 +132,37 @@ func NewRootPrincipal() Principal {
 	return &rootPrincipal{}
 }
 
+func (p rootPrincipal) User() string {
+	return "root"
+}
+
+func (p rootPrincipal) Groups() []string {
+	return []string{"root"}
+}
+
+func (p rootPrincipal) Umask() string {
+	return ""
+}
+
+func (p rootPrincipal) Scope() *TokenScope {
+	return nil
+}
+
+// IsSuperuser reports whether the principal is the root principal or a member
+// of the superuser group.
+func IsSuperuser(p Principal, superuserGroup string) bool {
+	if _, ok := p.(*rootPrincipal); ok {
+		return true
+	}
+
+	return slices.Contains(p.Groups(), superuserGroup)
+}
+
 func (p rootPrincipal) ComputePrivileges(_ ...HasPermissions) Privileges {
 	return Privileges{
-		Read:   true,
-		Write:  true,
-		Delete: true,
+		Read:    true,
+		Write:   true,
+		Delete:  true,
+		Execute: true,
 	}
 }
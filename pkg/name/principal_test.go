@@ -98,9 +98,10 @@ func TestPrincipal_ComputePrivileges(t *testing.T) {
 				},
 			},
 			expected: name.Privileges{
-				Read:   true,
-				Write:  true,
-				Delete: true,
+				Read:    true,
+				Write:   true,
+				Delete:  true,
+				Execute: true,
 			},
 		},
 	}
@@ -1,15 +1,17 @@
 package name
 
 type Privileges struct {
-	Read   bool
-	Write  bool
-	Delete bool
+	Read    bool
+	Write   bool
+	Delete  bool
+	Execute bool
 }
 
 func (p Privileges) Union(o Privileges) Privileges {
 	return Privileges{
-		Read:   p.Read && o.Read,
-		Write:  p.Write && o.Write,
-		Delete: p.Delete && o.Delete,
+		Read:    p.Read && o.Read,
+		Write:   p.Write && o.Write,
+		Delete:  p.Delete && o.Delete,
+		Execute: p.Execute && o.Execute,
 	}
 }
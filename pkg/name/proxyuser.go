@@ -0,0 +1,88 @@
+package name
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ProxyWildcard allows a proxy to impersonate all users or all groups.
+const ProxyWildcard = "*"
+
+// ProxyUser lists whom a trusted service account may impersonate by sending
+// the doAs metadata.
+type ProxyUser struct {
+	// Users may be impersonated by name.
+	Users []string
+	// Groups whose members may be impersonated.
+	Groups []string
+}
+
+// Allows reports whether user may be impersonated.
+func (p ProxyUser) Allows(user User) bool {
+	if slices.Contains(p.Users, ProxyWildcard) || slices.Contains(p.Users, user.Name) {
+		return true
+	}
+
+	if slices.Contains(p.Groups, ProxyWildcard) {
+		return true
+	}
+
+	for _, group := range user.Groups {
+		if slices.Contains(p.Groups, group.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseProxyUsers reads lines of a service account followed by the users and
+// groups it may impersonate, as in "gateway users=alice,bob groups=staff".
+// Empty lines and lines starting with # are ignored.
+func ParseProxyUsers(r io.Reader) (map[string]ProxyUser, error) {
+	proxyUsers := map[string]ProxyUser{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected service account and users or groups", lineNumber)
+		}
+
+		proxyUser := ProxyUser{}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok || value == "" {
+				return nil, fmt.Errorf("line %d: expected key=value but was '%s'", lineNumber, field)
+			}
+
+			switch key {
+			case "users":
+				proxyUser.Users = append(proxyUser.Users, strings.Split(value, ",")...)
+			case "groups":
+				proxyUser.Groups = append(proxyUser.Groups, strings.Split(value, ",")...)
+			default:
+				return nil, fmt.Errorf("line %d: unknown key '%s'", lineNumber, key)
+			}
+		}
+
+		proxyUsers[fields[0]] = proxyUser
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("could not read proxy users: %w", err)
+	}
+
+	return proxyUsers, nil
+}
@@ -0,0 +1,126 @@
+package name_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseProxyUsers(t *testing.T) {
+	proxyUsers, err := name.ParseProxyUsers(strings.NewReader("# proxies\n" +
+		"gateway users=alice,bob groups=staff\n" +
+		"\n" +
+		"etl groups=*\n"))
+	assert.NoError(t, err)
+	assert.Len(t, proxyUsers, 2)
+	assert.Equal(t, []string{"alice", "bob"}, proxyUsers["gateway"].Users)
+	assert.Equal(t, []string{"staff"}, proxyUsers["gateway"].Groups)
+	assert.True(t, proxyUsers["etl"].Allows(name.User{Name: "carol"}))
+
+	_, err = name.ParseProxyUsers(strings.NewReader("gateway\n"))
+	assert.Error(t, err)
+
+	_, err = name.ParseProxyUsers(strings.NewReader("gateway hosts=localhost\n"))
+	assert.Error(t, err)
+}
+
+func TestProxyUser_Allows(t *testing.T) {
+	proxyUser := name.ProxyUser{Users: []string{"alice"}, Groups: []string{"staff"}}
+
+	assert.True(t, proxyUser.Allows(name.User{Name: "alice"}))
+	assert.True(t, proxyUser.Allows(name.User{Name: "bob", Groups: []*name.Group{{Name: "staff"}}}))
+	assert.False(t, proxyUser.Allows(name.User{Name: "carol", Groups: []*name.Group{{Name: "dev"}}}))
+}
+
+func TestAuthInterceptor_DoAs(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	interceptor, err := name.NewAuthInterceptor(name.AuthInterceptorOpts{
+		Logger:          logrus.New(),
+		SecurityService: securityService,
+		ProxyUsers: map[string]name.ProxyUser{
+			"gateway": {Groups: []string{"staff"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	for _, user := range []string{"gateway", "alice", "bob"} {
+		err = securityService.CreateUser(name.User{Name: user, Password: "secret"})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, securityService.CreateGroup(name.Group{Name: "staff"}))
+	assert.NoError(t, securityService.AddUserToGroup("alice", "staff"))
+
+	gatewayToken, err := securityService.AuthenticateUser("gateway", "secret")
+	assert.NoError(t, err)
+	aliceToken, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/name.Name/List"}
+	var principal name.Principal
+	handler := func(ctx context.Context, req any) (any, error) {
+		principal, _ = name.PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+	doAs := func(token string, user string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			proto.AuthorizationHeader, proto.BearerPrefix+token,
+			proto.DoAsHeader, user))
+	}
+
+	_, err = interceptor.Unary()(doAs(gatewayToken, "alice"), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", principal.User())
+	assert.Equal(t, []string{"staff"}, principal.Groups())
+
+	_, err = interceptor.Unary()(doAs(gatewayToken, "bob"), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor.Unary()(doAs(gatewayToken, "nobody"), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor.Unary()(doAs(aliceToken, "bob"), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor.Unary()(doAs(aliceToken, "alice"), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", principal.User())
+}
+
+func TestFileService_SuperuserGroup(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger:         createLogger(t),
+		DB:             createDB(t),
+		SuperuserGroup: "admins",
+	})
+	assert.NoError(t, err)
+
+	alice := name.NewPrincipal(name.User{Name: "alice"})
+	admin := name.NewPrincipal(name.User{Name: "admin", Groups: []*name.Group{{Name: "admins"}}})
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+
+	_, err = service.CreateDir(alice, "/private", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+	_, err = service.CreateFile(alice, "/private/secret.txt", &name.Permissions{OwnerPermission: all})
+	assert.NoError(t, err)
+
+	_, err = service.List(admin, "/private")
+	assert.NoError(t, err)
+	_, err = service.Stat(admin, "/private/secret.txt")
+	assert.NoError(t, err)
+	_, err = service.CreateFile(admin, "/private/admin.txt", nil)
+	assert.NoError(t, err)
+	err = service.DeleteFile(admin, "/private/secret.txt")
+	assert.NoError(t, err)
+
+	_, err = service.Stat(name.NewPrincipal(name.User{Name: "bob"}), "/private/admin.txt")
+	assert.Error(t, err)
+}
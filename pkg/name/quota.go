@@ -0,0 +1,561 @@
+package name
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrQuotaExceeded is returned for changes that would take a directory or a
+// user beyond their quota.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Quota limits what may be stored below a directory. A limit of 0 means no
+// limit. The usage is only kept up to date while a limit is set.
+type Quota struct {
+	// Namespace is the number of files and directories below the directory.
+	Namespace     uint64 `gorm:"not null;default:0"`
+	NamespaceUsed uint64 `gorm:"not null;default:0"`
+	// Space is the number of bytes below the directory times the number of
+	// replicas.
+	Space     uint64 `gorm:"not null;default:0"`
+	SpaceUsed uint64 `gorm:"not null;default:0"`
+}
+
+func (q Quota) IsSet() bool {
+	return q.Namespace > 0 || q.Space > 0
+}
+
+// UserQuota limits the space, in bytes times the number of replicas, taken
+// by the files a user owns. Users without one are not limited.
+type UserQuota struct {
+	UserName  string `gorm:"column:user_name;primaryKey"`
+	Space     uint64 `gorm:"column:space;not null;default:0"`
+	SpaceUsed uint64 `gorm:"column:space_used;not null;default:0"`
+}
+
+// BlockReservation holds the space charged for a new block from when a
+// client gets the token to write it until a node reports it, so that the
+// quotas are checked before the block is stored.
+type BlockReservation struct {
+	BlockID    string    `gorm:"primaryKey"`
+	FileInfoID uint64    `gorm:"not null;index"`
+	Owner      string    `gorm:"not null"`
+	Space      uint64    `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"`
+}
+
+// ContentSummary counts what is stored at and below a path.
+type ContentSummary struct {
+	Dirs   uint64
+	Files  uint64
+	Length uint64
+	// SpaceConsumed is Length times the number of replicas.
+	SpaceConsumed uint64
+	// Quota is the quota of the path, if it is a directory with one.
+	Quota Quota
+}
+
+// space returns the space taken by length bytes with all their replicas.
+func (f *fileService) space(length uint64) uint64 {
+	return length * uint64(f.Opts.Replication)
+}
+
+// charge accounts for entries new files or directories and space bytes below
+// the last of dirs, as returned by lookup, and for space bytes of owner.
+// Negative amounts release what was taken. Growing beyond a quota fails with
+// ErrQuotaExceeded.
+func (f *fileService) charge(tx *gorm.DB, dirs []FileInfo, owner string, entries int64, space int64) error {
+	var ids []uint64
+
+	for i, dir := range dirs {
+		if !dir.Quota.IsSet() {
+			continue
+		}
+
+		quota := dir.Quota
+		if entries > 0 && quota.Namespace > 0 && quota.NamespaceUsed+uint64(entries) > quota.Namespace {
+			return fmt.Errorf("namespace quota of %d entries of %s: %w", quota.Namespace, chainPath(dirs[:i+1]), ErrQuotaExceeded)
+		}
+		if space > 0 && quota.Space > 0 && quota.SpaceUsed+uint64(space) > quota.Space {
+			return fmt.Errorf("space quota of %d bytes of %s: %w", quota.Space, chainPath(dirs[:i+1]), ErrQuotaExceeded)
+		}
+
+		ids = append(ids, dir.ID)
+	}
+
+	if len(ids) > 0 && (entries != 0 || space != 0) {
+		err := tx.Model(&FileInfo{}).Where("id IN ?", ids).UpdateColumns(map[string]interface{}{
+			"quota_namespace_used": gorm.Expr("quota_namespace_used + ?", entries),
+			"quota_space_used":     gorm.Expr("quota_space_used + ?", space),
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update quota usage: %w", err)
+		}
+	}
+
+	if space == 0 || owner == "" {
+		return nil
+	}
+
+	var userQuotas []UserQuota
+	err := tx.Where("user_name = ?", owner).Find(&userQuotas).Error
+	if err != nil {
+		return fmt.Errorf("failed to get quota of user %s: %w", owner, err)
+	}
+	if len(userQuotas) == 0 {
+		return nil
+	}
+
+	userQuota := userQuotas[0]
+	if space > 0 && userQuota.SpaceUsed+uint64(space) > userQuota.Space {
+		return fmt.Errorf("space quota of %d bytes of user %s: %w", userQuota.Space, owner, ErrQuotaExceeded)
+	}
+
+	err = tx.Model(&UserQuota{}).Where("user_name = ?", owner).
+		UpdateColumn("space_used", gorm.Expr("space_used + ?", space)).Error
+	if err != nil {
+		return fmt.Errorf("failed to update quota usage of user %s: %w", owner, err)
+	}
+
+	return nil
+}
+
+// transferSpace charges the space of the files among fileInfos to owner and
+// releases it from their former owners.
+func (f *fileService) transferSpace(tx *gorm.DB, fileInfos []FileInfo, owner string) error {
+	var owned []struct {
+		Owner  string
+		Length uint64
+	}
+
+	err := tx.Model(&BlockInfo{}).
+		Joins("JOIN file_infos ON file_infos.id = block_infos.file_info_id").
+		Where("block_infos.file_info_id IN ? AND file_infos.permissions_owner <> ?", fileInfoIDs(fileInfos), owner).
+		Select("file_infos.permissions_owner AS owner, SUM(block_infos.length) AS length").
+		Group("file_infos.permissions_owner").
+		Scan(&owned).Error
+	if err != nil {
+		return fmt.Errorf("failed to sum block lengths: %w", err)
+	}
+
+	for _, entry := range owned {
+		space := int64(f.space(entry.Length))
+
+		err = f.charge(tx, nil, owner, 0, space)
+		if err != nil {
+			return err
+		}
+
+		err = f.charge(tx, nil, entry.Owner, 0, -space)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ancestors returns the directories above the file with the given id, from
+// the root down, as lookup does.
+func ancestors(tx *gorm.DB, id uint64) ([]FileInfo, error) {
+	var dirs []FileInfo
+
+	fileInfo := FileInfo{}
+	err := tx.First(&fileInfo, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file %d: %w", id, err)
+	}
+
+	for fileInfo.ParentID != nil {
+		parent := FileInfo{}
+		err = tx.First(&parent, *fileInfo.ParentID).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get directory %d: %w", *fileInfo.ParentID, err)
+		}
+
+		dirs = append([]FileInfo{parent}, dirs...)
+		fileInfo = parent
+	}
+
+	return dirs, nil
+}
+
+// releaseReservations releases the space of the reservations matching the
+// query and deletes them.
+func (f *fileService) releaseReservations(tx *gorm.DB, query string, args ...interface{}) error {
+	var reservations []BlockReservation
+
+	err := tx.Where(query, args...).Find(&reservations).Error
+	if err != nil {
+		return fmt.Errorf("failed to get block reservations: %w", err)
+	}
+
+	for _, reservation := range reservations {
+		dirs, err := ancestors(tx, reservation.FileInfoID)
+		if err != nil {
+			return err
+		}
+
+		err = f.charge(tx, dirs, reservation.Owner, 0, -int64(reservation.Space))
+		if err != nil {
+			return err
+		}
+
+		err = tx.Delete(&reservation).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete block reservation %s: %w", reservation.BlockID, err)
+		}
+	}
+
+	return nil
+}
+
+// chargeBlock charges a new block of fileInfo, releasing the space reserved
+// for it in fileInfo first.
+func (f *fileService) chargeBlock(tx *gorm.DB, fileInfo FileInfo, blockInfo BlockInfo) error {
+	err := f.releaseReservations(tx, "block_id = ? AND file_info_id = ?", blockInfo.ID, fileInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	// the usage of the directories changed with the release
+	dirs, err := ancestors(tx, fileInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	return f.charge(tx, dirs, fileInfo.Permissions.Owner, 0, int64(f.space(uint64(blockInfo.Length))))
+}
+
+// ReserveBlock charges length bytes of the new block blockID of the file at
+// path to the quotas until a node reports the block or until expiresAt, and
+// fails with ErrQuotaExceeded when they do not fit. Expired reservations are
+// released before. A block reserved for another file can not be reserved
+// until its reservation expires.
+func (f *fileService) ReserveBlock(path string, blockID string, length uint64, expiresAt time.Time) error {
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := f.releaseReservations(tx, "expires_at < ?", time.Now())
+		if err != nil {
+			return err
+		}
+
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		fileInfo := fileInfos[len(fileInfos)-1]
+		if fileInfo.IsDir {
+			return fmt.Errorf("%s is a directory", path)
+		}
+
+		var reserved int64
+		err = tx.Model(&BlockReservation{}).
+			Where("block_id = ? AND (file_info_id <> ? OR owner <> ?)", blockID, fileInfo.ID, fileInfo.Permissions.Owner).
+			Count(&reserved).Error
+		if err != nil {
+			return fmt.Errorf("failed to get block reservations: %w", err)
+		}
+		if reserved > 0 {
+			return fmt.Errorf("block %s is reserved for another file", blockID)
+		}
+
+		// a new token for the same block replaces its reservation
+		err = f.releaseReservations(tx, "block_id = ?", blockID)
+		if err != nil {
+			return err
+		}
+
+		// the usage of the directories changed with the release
+		fileInfos, err = f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		var count int64
+		err = tx.Model(&BlockInfo{}).Where("id = ?", blockID).Count(&count).Error
+		if err != nil {
+			return fmt.Errorf("failed to get block %s: %w", blockID, err)
+		}
+		if count > 0 {
+			return fmt.Errorf("block %s already exists", blockID)
+		}
+
+		reservation := BlockReservation{
+			BlockID:    blockID,
+			FileInfoID: fileInfo.ID,
+			Owner:      fileInfo.Permissions.Owner,
+			Space:      f.space(length),
+			ExpiresAt:  expiresAt,
+		}
+
+		err = f.charge(tx, fileInfos[:len(fileInfos)-1], reservation.Owner, 0, int64(reservation.Space))
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&reservation).Error
+		if err != nil {
+			return fmt.Errorf("failed to create block reservation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reserve block %s of %s: %w", blockID, path, err)
+	}
+
+	return nil
+}
+
+// reservedSpace returns the space reserved for new blocks of the files with
+// the given ids.
+func reservedSpace(tx *gorm.DB, ids []uint64) (uint64, error) {
+	var space uint64
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	err := tx.Model(&BlockReservation{}).Where("file_info_id IN ?", ids).Select("COALESCE(SUM(space), 0)").Scan(&space).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum block reservations: %w", err)
+	}
+
+	return space, nil
+}
+
+// blockLength returns the number of bytes in the blocks of the files with
+// the given ids.
+func blockLength(tx *gorm.DB, ids []uint64) (uint64, error) {
+	var length uint64
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	err := tx.Model(&BlockInfo{}).Where("file_info_id IN ?", ids).Select("COALESCE(SUM(length), 0)").Scan(&length).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum block lengths: %w", err)
+	}
+
+	return length, nil
+}
+
+// ownedLength returns the number of bytes in the blocks of the files owned by
+// user.
+func ownedLength(tx *gorm.DB, user string) (uint64, error) {
+	var length uint64
+
+	err := tx.Model(&BlockInfo{}).
+		Joins("JOIN file_infos ON file_infos.id = block_infos.file_info_id").
+		Where("file_infos.permissions_owner = ?", user).
+		Select("COALESCE(SUM(block_infos.length), 0)").
+		Scan(&length).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum block lengths of user %s: %w", user, err)
+	}
+
+	return length, nil
+}
+
+// summarize counts the files, directories and bytes of fileInfos, as returned
+// by subtree.
+func (f *fileService) summarize(tx *gorm.DB, fileInfos []FileInfo) (ContentSummary, error) {
+	var summary ContentSummary
+	var files []uint64
+
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir {
+			summary.Dirs++
+		} else {
+			summary.Files++
+			files = append(files, fileInfo.ID)
+		}
+	}
+
+	length, err := blockLength(tx, files)
+	if err != nil {
+		return ContentSummary{}, err
+	}
+
+	summary.Length = length
+	summary.SpaceConsumed = f.space(length)
+
+	return summary, nil
+}
+
+// SetQuota limits the number of entries and the space below the directory at
+// path. Limits of 0 remove them. The usage is counted anew, it may already
+// exceed the limits, which only prevents further growth. Only superusers may
+// set quotas.
+func (f *fileService) SetQuota(p Principal, path string, namespace uint64, space uint64) error {
+	if !IsSuperuser(p, f.Opts.SuperuserGroup) || p.Scope() != nil {
+		return fmt.Errorf("failed to set quota of %s: only superusers may set quotas", path)
+	}
+
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		dir := fileInfos[len(fileInfos)-1]
+		if !dir.IsDir {
+			return fmt.Errorf("%s is not a directory", path)
+		}
+
+		quota := Quota{Namespace: namespace, Space: space}
+		if quota.IsSet() {
+			subtree, err := f.subtree(tx, dir, true)
+			if err != nil {
+				return err
+			}
+
+			summary, err := f.summarize(tx, subtree[1:])
+			if err != nil {
+				return err
+			}
+
+			reserved, err := reservedSpace(tx, fileInfoIDs(subtree))
+			if err != nil {
+				return err
+			}
+
+			quota.NamespaceUsed = summary.Dirs + summary.Files
+			quota.SpaceUsed = summary.SpaceConsumed + reserved
+		}
+
+		err = tx.Model(&FileInfo{}).Where("id = ?", dir.ID).UpdateColumns(map[string]interface{}{
+			"quota_namespace":      quota.Namespace,
+			"quota_namespace_used": quota.NamespaceUsed,
+			"quota_space":          quota.Space,
+			"quota_space_used":     quota.SpaceUsed,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update quota: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set quota of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// SetUserQuota limits the space taken by the files of user. A limit of 0
+// removes it. Only superusers may set quotas.
+func (f *fileService) SetUserQuota(p Principal, user string, space uint64) error {
+	if !IsSuperuser(p, f.Opts.SuperuserGroup) || p.Scope() != nil {
+		return fmt.Errorf("failed to set quota of user %s: only superusers may set quotas", user)
+	}
+
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		if space == 0 {
+			err := tx.Where("user_name = ?", user).Delete(&UserQuota{}).Error
+			if err != nil {
+				return fmt.Errorf("failed to delete quota: %w", err)
+			}
+
+			return nil
+		}
+
+		length, err := ownedLength(tx, user)
+		if err != nil {
+			return err
+		}
+
+		var reserved uint64
+		err = tx.Model(&BlockReservation{}).Where("owner = ?", user).Select("COALESCE(SUM(space), 0)").Scan(&reserved).Error
+		if err != nil {
+			return fmt.Errorf("failed to sum block reservations: %w", err)
+		}
+
+		err = tx.Save(&UserQuota{UserName: user, Space: space, SpaceUsed: f.space(length) + reserved}).Error
+		if err != nil {
+			return fmt.Errorf("failed to save quota: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set quota of user %s: %w", user, err)
+	}
+
+	return nil
+}
+
+// GetUserQuota returns the quota of user, with a Space of 0 if there is
+// none. Users may get their own quota, superusers the one of everybody.
+func (f *fileService) GetUserQuota(p Principal, user string) (UserQuota, error) {
+	if p.User() != user && !IsSuperuser(p, f.Opts.SuperuserGroup) {
+		return UserQuota{}, fmt.Errorf("failed to get quota of user %s: permission denied", user)
+	}
+
+	userQuota := UserQuota{UserName: user}
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		var userQuotas []UserQuota
+		err := tx.Where("user_name = ?", user).Find(&userQuotas).Error
+		if err != nil {
+			return fmt.Errorf("failed to get quota: %w", err)
+		}
+		if len(userQuotas) > 0 {
+			userQuota = userQuotas[0]
+			return nil
+		}
+
+		length, err := ownedLength(tx, user)
+		if err != nil {
+			return err
+		}
+		userQuota.SpaceUsed = f.space(length)
+
+		return nil
+	}, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return UserQuota{}, fmt.Errorf("failed to get quota of user %s: %w", user, err)
+	}
+
+	return userQuota, nil
+}
+
+// GetContentSummary counts the directories, files and bytes at and below
+// path, along with its quota.
+func (f *fileService) GetContentSummary(p Principal, path string) (ContentSummary, error) {
+	var summary ContentSummary
+	err := f.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		fileInfos, err := f.lookup(tx, path)
+		if err != nil {
+			return fmt.Errorf("failed to lookup %s: %w", path, err)
+		}
+
+		if !f.canRead(p, fileInfos) {
+			return fmt.Errorf("permission denied for %s", path)
+		}
+
+		target := fileInfos[len(fileInfos)-1]
+		subtree, err := f.subtree(tx, target, target.IsDir)
+		if err != nil {
+			return err
+		}
+
+		summary, err = f.summarize(tx, subtree)
+		if err != nil {
+			return err
+		}
+		summary.Quota = target.Quota
+
+		return nil
+	}, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return ContentSummary{}, fmt.Errorf("failed to count %s: %w", path, err)
+	}
+
+	return summary, nil
+}
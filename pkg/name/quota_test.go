@@ -0,0 +1,296 @@
+package name_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createQuotaFileService(t *testing.T, replication uint) name.FileService {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger:      createLogger(t),
+		DB:          createDB(t),
+		Replication: replication,
+	})
+	assert.NoError(t, err)
+
+	return service
+}
+
+func addBlock(service name.FileService, path string, blockID string, host string, sequence uint64, length uint32) error {
+	return service.NotifyBlockAdded(&proto.NotifyBlockAddedRequest{
+		Host:     host,
+		BlockId:  blockID,
+		Path:     path,
+		Sequence: sequence,
+		Length:   length,
+	})
+}
+
+func TestFileService_DirQuota(t *testing.T) {
+	service := createQuotaFileService(t, 3)
+	root := name.NewRootPrincipal()
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}}})
+
+	_, err := service.CreateDir(root, "/data", nil)
+	assert.NoError(t, err)
+
+	assert.Error(t, service.SetQuota(alice, "/data", 3, 3000))
+	assert.NoError(t, service.SetQuota(root, "/data", 3, 3000))
+
+	_, err = service.CreateDir(root, "/data/a", nil)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(root, "/data/a/f1", nil)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(root, "/data/f2", nil)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(root, "/data/f3", nil)
+	assert.ErrorIs(t, err, name.ErrQuotaExceeded)
+	_, err = service.CreateDir(root, "/data/a/b", nil)
+	assert.ErrorIs(t, err, name.ErrQuotaExceeded)
+
+	// space is charged once per block, times the number of replicas
+	assert.NoError(t, addBlock(service, "/data/f2", "block-1", "host-1", 0, 600))
+	assert.NoError(t, addBlock(service, "/data/f2", "block-1", "host-2", 0, 600))
+	assert.ErrorIs(t, addBlock(service, "/data/f2", "block-2", "host-1", 1, 500), name.ErrQuotaExceeded)
+
+	blockInfos, err := service.GetBlockInfos(root, "/data/f2")
+	assert.NoError(t, err)
+	assert.Len(t, blockInfos, 1)
+
+	summary, err := service.GetContentSummary(root, "/data")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), summary.Dirs)
+	assert.Equal(t, uint64(2), summary.Files)
+	assert.Equal(t, uint64(600), summary.Length)
+	assert.Equal(t, uint64(1800), summary.SpaceConsumed)
+	assert.Equal(t, name.Quota{Namespace: 3, NamespaceUsed: 3, Space: 3000, SpaceUsed: 1800}, summary.Quota)
+
+	assert.NoError(t, service.DeleteFile(root, "/data/f2"))
+	summary, err = service.GetContentSummary(root, "/data")
+	assert.NoError(t, err)
+	assert.Equal(t, name.Quota{Namespace: 3, NamespaceUsed: 2, Space: 3000, SpaceUsed: 0}, summary.Quota)
+
+	_, err = service.CreateFile(root, "/data/f3", nil)
+	assert.NoError(t, err)
+
+	// quotas apply to the whole subtree
+	assert.NoError(t, service.SetQuota(root, "/data", 0, 0))
+	_, err = service.CreateFile(root, "/data/f4", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, service.SetQuota(root, "/data", 10, 0))
+	summary, err = service.GetContentSummary(root, "/data")
+	assert.NoError(t, err)
+	assert.Equal(t, name.Quota{Namespace: 10, NamespaceUsed: 4}, summary.Quota)
+
+	assert.Error(t, service.SetQuota(root, "/data/f3", 10, 0))
+}
+
+func TestFileService_UserQuota(t *testing.T) {
+	service := createQuotaFileService(t, 1)
+	root := name.NewRootPrincipal()
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "staff"}}})
+
+	for _, path := range []string{"/a", "/b"} {
+		_, err := service.CreateFile(root, path, &name.Permissions{
+			Owner:           "alice",
+			Group:           "staff",
+			OwnerPermission: name.Permission{Read: true, Write: true, Delete: true},
+		})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, addBlock(service, "/a", "block-a", "host-1", 0, 600))
+
+	assert.Error(t, service.SetUserQuota(alice, "alice", 1000))
+	assert.NoError(t, service.SetUserQuota(root, "alice", 1000))
+
+	userQuota, err := service.GetUserQuota(alice, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, name.UserQuota{UserName: "alice", Space: 1000, SpaceUsed: 600}, userQuota)
+	_, err = service.GetUserQuota(bob, "alice")
+	assert.Error(t, err)
+
+	assert.ErrorIs(t, addBlock(service, "/b", "block-b", "host-1", 0, 600), name.ErrQuotaExceeded)
+
+	// changing the owner moves the space to the new owner
+	assert.NoError(t, service.SetOwner(root, "/a", "bob", "", false))
+	userQuota, err = service.GetUserQuota(alice, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), userQuota.SpaceUsed)
+	userQuota, err = service.GetUserQuota(bob, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, name.UserQuota{UserName: "bob", SpaceUsed: 600}, userQuota)
+
+	assert.NoError(t, addBlock(service, "/b", "block-b", "host-1", 0, 600))
+
+	assert.NoError(t, service.SetUserQuota(root, "bob", 1000))
+	assert.ErrorIs(t, service.SetOwner(root, "/b", "bob", "", false), name.ErrQuotaExceeded)
+
+	assert.NoError(t, service.DeleteFile(root, "/a"))
+	userQuota, err = service.GetUserQuota(bob, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), userQuota.SpaceUsed)
+
+	assert.NoError(t, service.SetUserQuota(root, "bob", 0))
+	userQuota, err = service.GetUserQuota(root, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), userQuota.Space)
+}
+
+func TestServer_Quota(t *testing.T) {
+	service := createQuotaFileService(t, 1)
+	server := name.Server{Opts: name.ServerOpts{
+		Logger:      createLogger(t),
+		FileService: service,
+	}}
+	ctx := name.ContextWithPrincipal(context.Background(), name.NewRootPrincipal())
+
+	_, err := server.CreateDir(ctx, &proto.CreateDirRequest{Path: "/data"})
+	assert.NoError(t, err)
+	_, err = server.SetQuota(ctx, &proto.SetQuotaRequest{Path: "/data", Namespace: 1})
+	assert.NoError(t, err)
+
+	_, err = server.CreateFile(ctx, &proto.CreateFileRequest{Path: "/data/f1"})
+	assert.NoError(t, err)
+	_, err = server.CreateFile(ctx, &proto.CreateFileRequest{Path: "/data/f2"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	summary, err := server.GetContentSummary(ctx, &proto.GetContentSummaryRequest{Path: "/data"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), summary.GetFileCount())
+	assert.Equal(t, uint64(1), summary.GetQuota().GetNamespace())
+	assert.Equal(t, uint64(1), summary.GetQuota().GetNamespaceUsed())
+
+	userQuota, err := server.GetUserQuota(ctx, &proto.GetUserQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "root", userQuota.GetUser())
+}
+
+func TestFileService_ReserveBlock(t *testing.T) {
+	service := createQuotaFileService(t, 3)
+	root := name.NewRootPrincipal()
+	expiresAt := time.Now().Add(time.Hour)
+	spaceUsed := func() uint64 {
+		summary, err := service.GetContentSummary(root, "/data")
+		assert.NoError(t, err)
+		return summary.Quota.SpaceUsed
+	}
+
+	_, err := service.CreateDir(root, "/data", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, service.SetQuota(root, "/data", 0, 3000))
+	_, err = service.CreateFile(root, "/data/f1", nil)
+	assert.NoError(t, err)
+
+	// new blocks are charged before they are written
+	assert.NoError(t, service.ReserveBlock("/data/f1", "block-1", 600, expiresAt))
+	assert.Equal(t, uint64(1800), spaceUsed())
+	assert.ErrorIs(t, service.ReserveBlock("/data/f1", "block-2", 500, expiresAt), name.ErrQuotaExceeded)
+	assert.Equal(t, uint64(1800), spaceUsed())
+
+	// the reservation is replaced by the length the node reports
+	assert.NoError(t, addBlock(service, "/data/f1", "block-1", "host-1", 0, 400))
+	assert.Equal(t, uint64(1200), spaceUsed())
+	assert.Error(t, service.ReserveBlock("/data/f1", "block-1", 400, expiresAt))
+
+	// the usage is counted anew including reservations
+	assert.NoError(t, service.ReserveBlock("/data/f1", "block-2", 100, expiresAt))
+	assert.NoError(t, service.SetQuota(root, "/data", 0, 3000))
+	assert.Equal(t, uint64(1500), spaceUsed())
+
+	// expired reservations are released by the next reservation
+	assert.NoError(t, service.ReserveBlock("/data/f1", "block-3", 100, time.Now().Add(-time.Minute)))
+	assert.Equal(t, uint64(1800), spaceUsed())
+	assert.NoError(t, service.ReserveBlock("/data/f1", "block-4", 200, expiresAt))
+	assert.Equal(t, uint64(2100), spaceUsed())
+
+	// deleting the file releases its blocks and reservations
+	assert.NoError(t, service.DeleteFile(root, "/data/f1"))
+	assert.Equal(t, uint64(0), spaceUsed())
+}
+
+func TestFileService_ReserveBlock_OtherFile(t *testing.T) {
+	service := createQuotaFileService(t, 1)
+	root := name.NewRootPrincipal()
+	expiresAt := time.Now().Add(time.Hour)
+	spaceUsed := func(path string) uint64 {
+		summary, err := service.GetContentSummary(root, path)
+		assert.NoError(t, err)
+		return summary.Quota.SpaceUsed
+	}
+
+	for _, dir := range []string{"/a", "/b"} {
+		_, err := service.CreateDir(root, dir, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, service.SetQuota(root, dir, 0, 1000))
+		_, err = service.CreateFile(root, dir+"/f", nil)
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, service.ReserveBlock("/a/f", "block-1", 600, expiresAt))
+
+	// the reservation of another file is neither released nor taken over
+	assert.Error(t, service.ReserveBlock("/b/f", "block-1", 100, expiresAt))
+	assert.Equal(t, uint64(600), spaceUsed("/a"))
+	assert.Equal(t, uint64(0), spaceUsed("/b"))
+
+	assert.NoError(t, addBlock(service, "/b/f", "block-1", "host-1", 0, 100))
+	assert.Equal(t, uint64(600), spaceUsed("/a"))
+	assert.Equal(t, uint64(100), spaceUsed("/b"))
+
+	// the same file may reserve its block again
+	assert.NoError(t, service.ReserveBlock("/a/f", "block-2", 200, expiresAt))
+	assert.NoError(t, service.ReserveBlock("/a/f", "block-2", 300, expiresAt))
+	assert.Equal(t, uint64(900), spaceUsed("/a"))
+}
+
+func TestServer_GetBlockAccessToken_Quota(t *testing.T) {
+	keys := blocktoken.NewKeyring()
+	_, err := keys.Rotate(time.Hour)
+	assert.NoError(t, err)
+	service := createQuotaFileService(t, 1)
+	server := name.Server{Opts: name.ServerOpts{
+		Logger:      createLogger(t),
+		FileService: service,
+		BlockKeys:   keys,
+	}}
+	ctx := name.ContextWithPrincipal(context.Background(), name.NewRootPrincipal())
+
+	_, err = server.CreateDir(ctx, &proto.CreateDirRequest{Path: "/data"})
+	assert.NoError(t, err)
+	_, err = server.SetQuota(ctx, &proto.SetQuotaRequest{Path: "/data", Space: 1000})
+	assert.NoError(t, err)
+	_, err = server.CreateFile(ctx, &proto.CreateFileRequest{Path: "/data/f1"})
+	assert.NoError(t, err)
+
+	_, err = server.GetBlockAccessToken(ctx, &proto.GetBlockAccessTokenRequest{Path: "/data/f1", BlockId: "block-1", Write: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetBlockAccessToken(ctx, &proto.GetBlockAccessTokenRequest{Path: "/data/f1", BlockId: "block-1", Write: true, Length: 2000})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	resp, err := server.GetBlockAccessToken(ctx, &proto.GetBlockAccessTokenRequest{Path: "/data/f1", BlockId: "block-1", Write: true, Length: 600})
+	assert.NoError(t, err)
+	claims, err := keys.Verify(resp.GetAccessToken(), "block-1", blocktoken.OpWrite)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(600), claims.Length)
+
+	_, err = server.GetBlockAccessToken(ctx, &proto.GetBlockAccessTokenRequest{Path: "/data/f1", BlockId: "block-2", Write: true, Length: 600})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// existing blocks are rewritten without a reservation
+	assert.NoError(t, addBlock(service, "/data/f1", "block-1", "host-1", 0, 600))
+	resp, err = server.GetBlockAccessToken(ctx, &proto.GetBlockAccessTokenRequest{Path: "/data/f1", BlockId: "block-1", Write: true})
+	assert.NoError(t, err)
+	claims, err = keys.Verify(resp.GetAccessToken(), "block-1", blocktoken.OpWrite)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(600), claims.Length)
+}
@@ -0,0 +1,72 @@
+package name
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+const DefaultDelegationTokenMaxLifetime = 7 * 24 * time.Hour
+
+// TokenScope restricts what a delegated token may do on top of the
+// permissions of its user.
+type TokenScope struct {
+	// Paths limits the token to these paths and everything below them. The
+	// ancestors of the paths may only be traversed. All paths are allowed
+	// when empty.
+	Paths []string `json:"paths,omitempty"`
+	// Operations are the privileges the token may use at most.
+	Operations Privileges `json:"operations"`
+}
+
+func (s *TokenScope) Validate() error {
+	if s.Operations == (Privileges{}) {
+		return fmt.Errorf("no operations given")
+	}
+
+	for i, scopePath := range s.Paths {
+		if !strings.HasPrefix(scopePath, "/") {
+			return fmt.Errorf("path must start with / but was '%s'", scopePath)
+		}
+		s.Paths[i] = path.Clean(scopePath)
+	}
+
+	return nil
+}
+
+// Limit returns the part of privileges the scope allows on target.
+func (s TokenScope) Limit(target string, privileges Privileges) Privileges {
+	if len(s.Paths) == 0 {
+		return privileges.Union(s.Operations)
+	}
+
+	target = path.Clean("/" + target)
+	traversable := false
+
+	for _, scopePath := range s.Paths {
+		if isPathOrBelow(target, scopePath) {
+			return privileges.Union(s.Operations)
+		}
+		if isPathOrBelow(scopePath, target) {
+			traversable = true
+		}
+	}
+
+	if traversable {
+		return Privileges{Execute: privileges.Execute}
+	}
+
+	return Privileges{}
+}
+
+// scopeAllows reports whether the scope of p, if any, allows all of wanted
+// on target.
+func scopeAllows(p Principal, target string, wanted Privileges) bool {
+	scope := p.Scope()
+	if scope == nil {
+		return true
+	}
+
+	return scope.Limit(target, wanted) == wanted
+}
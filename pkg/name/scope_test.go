@@ -0,0 +1,169 @@
+package name_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenScope_Limit(t *testing.T) {
+	all := name.Privileges{Read: true, Write: true, Delete: true, Execute: true}
+	readOnly := name.Privileges{Read: true, Execute: true}
+	scope := name.TokenScope{Paths: []string{"/data/x"}, Operations: readOnly}
+
+	assert.Equal(t, readOnly, scope.Limit("/data/x", all))
+	assert.Equal(t, readOnly, scope.Limit("/data/x/y.txt", all))
+	assert.Equal(t, name.Privileges{Read: true}, scope.Limit("/data/x/y.txt", name.Privileges{Read: true, Write: true}))
+	assert.Equal(t, name.Privileges{Execute: true}, scope.Limit("/data", all))
+	assert.Equal(t, name.Privileges{Execute: true}, scope.Limit("/", all))
+	assert.Equal(t, name.Privileges{}, scope.Limit("/data/xy", all))
+	assert.Equal(t, name.Privileges{}, scope.Limit("/other", all))
+
+	unbounded := name.TokenScope{Operations: readOnly}
+	assert.Equal(t, readOnly, unbounded.Limit("/other", all))
+}
+
+func TestTokenScope_Validate(t *testing.T) {
+	scope := name.TokenScope{Paths: []string{"/data/x/"}, Operations: name.Privileges{Read: true}}
+	assert.NoError(t, scope.Validate())
+	assert.Equal(t, []string{"/data/x"}, scope.Paths)
+
+	scope = name.TokenScope{Paths: []string{"data"}, Operations: name.Privileges{Read: true}}
+	assert.Error(t, scope.Validate())
+
+	scope = name.TokenScope{Paths: []string{"/data"}}
+	assert.Error(t, scope.Validate())
+}
+
+func TestSecurityService_DelegationToken(t *testing.T) {
+	service := createSecurityService(t, createSecurityDB(t))
+	for _, user := range []string{"alice", "scheduler"} {
+		assert.NoError(t, service.CreateUser(name.User{Name: user, Password: "secret"}))
+	}
+	scope := name.TokenScope{Paths: []string{"/data/x"}, Operations: name.Privileges{Read: true, Execute: true}}
+
+	_, _, err := service.IssueDelegationToken("alice", scope, 2*time.Hour, "")
+	assert.Error(t, err)
+	_, _, err = service.IssueDelegationToken("alice", scope, time.Minute, "nobody")
+	assert.Error(t, err)
+	_, _, err = service.IssueDelegationToken("alice", name.TokenScope{}, time.Minute, "")
+	assert.Error(t, err)
+
+	token, expiresAt, err := service.IssueDelegationToken("alice", scope, time.Minute, "scheduler")
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, 5*time.Second)
+
+	user, err := service.LookupUserByToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", user.Name)
+	assert.Equal(t, &scope, user.Scope)
+	assert.Equal(t, &scope, name.NewPrincipal(user).Scope())
+
+	_, err = service.RenewDelegationToken("alice", token)
+	assert.Error(t, err)
+
+	renewedAt, err := service.RenewDelegationToken("scheduler", token)
+	assert.NoError(t, err)
+	assert.False(t, renewedAt.Before(expiresAt))
+
+	login, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	user, err = service.LookupUserByToken(login)
+	assert.NoError(t, err)
+	assert.Nil(t, user.Scope)
+	_, err = service.RenewDelegationToken("scheduler", login)
+	assert.Error(t, err)
+}
+
+func TestFileService_DelegatedPrincipal(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger:         createLogger(t),
+		DB:             createDB(t),
+		SuperuserGroup: "admins",
+	})
+	assert.NoError(t, err)
+
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+	perms := &name.Permissions{OwnerPermission: all}
+	alice := name.NewPrincipal(name.User{Name: "alice"})
+
+	for _, dir := range []string{"/data", "/data/x", "/other"} {
+		_, err = service.CreateDir(alice, dir, perms)
+		assert.NoError(t, err)
+	}
+	_, err = service.CreateFile(alice, "/data/x/part-0", perms)
+	assert.NoError(t, err)
+	_, err = service.CreateFile(alice, "/other/secret.txt", perms)
+	assert.NoError(t, err)
+
+	scope := &name.TokenScope{Paths: []string{"/data/x"}, Operations: name.Privileges{Read: true, Execute: true}}
+	job := name.NewPrincipal(name.User{Name: "alice", Scope: scope})
+
+	_, err = service.List(job, "/data/x")
+	assert.NoError(t, err)
+	_, err = service.Stat(job, "/data/x/part-0")
+	assert.NoError(t, err)
+
+	_, err = service.List(job, "/data")
+	assert.Error(t, err)
+	_, err = service.Stat(job, "/other/secret.txt")
+	assert.Error(t, err)
+	_, err = service.CreateFile(job, "/data/x/part-1", nil)
+	assert.Error(t, err)
+	err = service.DeleteFile(job, "/data/x/part-0")
+	assert.Error(t, err)
+	err = service.SetPermission(job, "/data/x/part-0", all, all, all, false, false, false)
+	assert.Error(t, err)
+
+	// the scope applies to superusers too
+	admin := name.NewPrincipal(name.User{Name: "admin", Groups: []*name.Group{{Name: "admins"}}, Scope: scope})
+	_, err = service.Stat(admin, "/data/x/part-0")
+	assert.NoError(t, err)
+	_, err = service.Stat(admin, "/other/secret.txt")
+	assert.Error(t, err)
+}
+
+func TestServer_DelegationToken(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	assert.NoError(t, securityService.CreateUser(name.User{Name: "alice", Password: "secret"}))
+	assert.NoError(t, securityService.CreateGroup(name.Group{Name: "admins"}))
+	assert.NoError(t, securityService.AddUserToGroup("alice", "admins"))
+	login, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	server := name.Server{Opts: name.ServerOpts{Logger: logrus.New(), SecurityService: securityService, SuperuserGroup: "admins"}}
+	adminServer, err := name.NewAdminServer(name.AdminServerOpts{Logger: logrus.New(), SecurityService: securityService, SuperuserGroup: "admins"})
+	assert.NoError(t, err)
+
+	resp, err := server.CreateDelegationToken(context.Background(), &proto.CreateDelegationTokenRequest{
+		Token:      login,
+		Paths:      []string{"/data/x"},
+		Operations: &proto.Permission{Read: true, Execute: true},
+		Lifetime:   int64((2 * time.Minute).Seconds()),
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.GetToken())
+
+	_, err = server.CreateDelegationToken(context.Background(), &proto.CreateDelegationTokenRequest{
+		Token:      resp.GetToken(),
+		Operations: &proto.Permission{Read: true},
+		Lifetime:   60,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.RevokeAllSessions(context.Background(), &proto.RevokeAllSessionsRequest{Token: resp.GetToken()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = adminServer.ListUsers(context.Background(), &proto.ListUsersRequest{Token: resp.GetToken()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = adminServer.ListUsers(context.Background(), &proto.ListUsersRequest{Token: login})
+	assert.NoError(t, err)
+}
@@ -2,10 +2,14 @@ package name
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/sirupsen/logrus"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -16,8 +20,33 @@ type User struct {
 	CreatedAt      time.Time `gorm:"column:created_at"`
 	UpdatedAt      time.Time `gorm:"column:updated_at"`
 	HashedPassword string    `gorm:"column:hp;not null"`
-	Groups         []*Group  `gorm:"many2many:user_group;"`
-	Tokens         []*Token  `gorm:"foreignKey:user_name;references:name"`
+	Salt           string    `gorm:"column:salt;not null;default:''"`
+	Iterations     int       `gorm:"column:iterations;not null;default:0"`
+	Groups         []*Group  `gorm:"many2many:user_groups;"`
+	Tokens         []*Token  `gorm:"foreignKey:UserName;references:Name"`
+	// Provider is the authenticator of users that are not authenticated by
+	// their stored password, empty for local users.
+	Provider string `gorm:"column:provider;not null;default:''"`
+	// Umask is applied to files the user creates without permissions, empty
+	// for the umask of the server.
+	Umask string `gorm:"column:umask;not null;default:''"`
+	// ServiceAccount users have no password and authenticate with API keys
+	// only.
+	ServiceAccount bool `gorm:"column:service_account;not null;default:false"`
+	// FailedLogins counts the failed logins since the last successful one or
+	// the last lockout.
+	FailedLogins      int        `gorm:"column:failed_logins;not null;default:0"`
+	LastFailedLoginAt *time.Time `gorm:"column:last_failed_login_at"`
+	// LockedUntil is set while the account is locked after too many failed
+	// logins.
+	LockedUntil *time.Time `gorm:"column:locked_until"`
+	// MustChangePassword makes the next login change the password.
+	MustChangePassword bool `gorm:"column:must_change_password;not null;default:false"`
+	// Password is hashed into HashedPassword by CreateUser and never stored.
+	Password string `gorm:"-"`
+	// Scope restricts users looked up by a delegated token, it is nil
+	// otherwise and never stored with the user.
+	Scope *TokenScope `gorm:"-"`
 }
 
 type Group struct {
@@ -31,15 +60,48 @@ type Token struct {
 	ID        uint64    `gorm:"column:id;autoIncrement;primaryKey"`
 	CreatedAt time.Time `gorm:"column:created_at"`
 	UpdatedAt time.Time `gorm:"column:updated_at"`
-	ExpiresAt time.Time `gorm:"column:expired_at; not null"`
-	Value     string    `gorm:"column:value;not null"`
-	User      User      `gorm:"column:user_name;foreignKey:name;not null"`
+	ExpiresAt time.Time `gorm:"column:expired_at; not null;index"`
+	// Value is the hex encoded SHA-256 of the token handed to the user.
+	Value    string `gorm:"column:value;not null;uniqueIndex"`
+	UserName string `gorm:"column:user_name;not null;index"`
+	User     User   `gorm:"foreignKey:UserName;references:Name"`
+	// Scope restricts delegated tokens, it is nil for tokens issued by a
+	// login.
+	Scope *TokenScope `gorm:"column:scope;serializer:json"`
+	// Renewer may extend a delegated token by Lifetime, but not beyond
+	// MaxExpiresAt.
+	Renewer      string        `gorm:"column:renewer;not null;default:''"`
+	Lifetime     time.Duration `gorm:"column:lifetime;not null;default:0"`
+	MaxExpiresAt *time.Time    `gorm:"column:max_expired_at"`
 }
 
 func (t *Token) IsExpired() bool {
 	return t.ExpiresAt.Before(time.Now())
 }
 
+const (
+	DefaultTokenCacheTTL = time.Minute
+	tokenLength          = 32
+)
+
+// newToken returns a random token to hand to a user.
+func newToken() (string, error) {
+	tokenBytes := make([]byte, tokenLength)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return "", fmt.Errorf("could not create token: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(tokenBytes), nil
+}
+
+// hashToken returns the value stored for a token, so that a leaked database
+// does not reveal usable tokens.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 type SecurityService interface {
 	CreateUser(user User) error
 	DeleteUser(userName string) error
@@ -52,15 +114,43 @@ type SecurityService interface {
 	AddUserToGroup(userName string, groupName string) error
 	RemoveUserFromGroup(userName string, groupName string) error
 	AuthenticateUser(userName string, password string) (string, error)
+	VerifyPassword(userName string, password string) (User, error)
+	IssueToken(user User) (string, error)
+	IssueDelegationToken(userName string, scope TokenScope, lifetime time.Duration, renewer string) (string, time.Time, error)
+	RenewDelegationToken(renewer string, token string) (time.Time, error)
 	ChangeUserPassword(userName string, newPassword string) error
+	SetUserUmask(userName string, umask string) error
 	Logout(token string) error
 	LookupUserByToken(token string) (User, error)
+	GetSessions(userName string) ([]Token, error)
+	RevokeSession(userName string, id uint64) error
+	RevokeAllSessions(userName string) error
+	PurgeExpiredTokens() (int64, error)
+	CreateAPIKey(userName string, expiresAt *time.Time) (string, APIKey, error)
+	GetAPIKeys(userName string) ([]APIKey, error)
+	RotateAPIKey(userName string, id uint64) (string, error)
+	RevokeAPIKey(userName string, id uint64) error
+	UnlockUser(userName string) error
+	ExpirePassword(userName string) error
 }
 
 type SecurityServiceOpts struct {
-	Logger           *logrus.Logger
-	DB               *gorm.DB
-	TokenExperiation time.Duration
+	Logger             *logrus.Logger
+	DB                 *gorm.DB
+	TokenExperiation   time.Duration
+	PasswordIterations int
+	// TokenCacheTTL is how long a validated token is served from memory.
+	// Revocations through this service take effect at once, changes made
+	// by other name servers sharing the database after at most the TTL.
+	TokenCacheTTL time.Duration
+	// DelegationTokenMaxLifetime is how long delegated tokens may be renewed
+	// after they were issued. DefaultDelegationTokenMaxLifetime is used when
+	// 0.
+	DelegationTokenMaxLifetime time.Duration
+	// PasswordPolicy is checked when passwords are set.
+	PasswordPolicy PasswordPolicy
+	// Lockout throttles failed logins of local users.
+	Lockout LockoutPolicy
 }
 
 func (o *SecurityServiceOpts) Validate() error {
@@ -73,37 +163,85 @@ func (o *SecurityServiceOpts) Validate() error {
 	if o.TokenExperiation == 0 {
 		return fmt.Errorf("token expiration is required")
 	}
+	if o.PasswordIterations < 0 {
+		return fmt.Errorf("password iterations must not be negative")
+	}
+	if o.TokenCacheTTL < 0 {
+		return fmt.Errorf("token cache ttl must not be negative")
+	}
+	if o.DelegationTokenMaxLifetime < 0 {
+		return fmt.Errorf("delegation token max lifetime must not be negative")
+	}
+	if err := o.PasswordPolicy.Validate(); err != nil {
+		return fmt.Errorf("password policy is invalid: %w", err)
+	}
+	if err := o.Lockout.Validate(); err != nil {
+		return fmt.Errorf("lockout policy is invalid: %w", err)
+	}
 
 	return nil
 }
 
 type securityService struct {
-	Opts SecurityServiceOpts
+	Opts  SecurityServiceOpts
+	cache *tokenCache
 }
 
 func NewSecurityService(opts SecurityServiceOpts) (SecurityService, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, fmt.Errorf("options are invalid: %w", err)
 	}
+	if opts.PasswordIterations == 0 {
+		opts.PasswordIterations = DefaultPasswordIterations
+	}
+	if opts.TokenCacheTTL == 0 {
+		opts.TokenCacheTTL = DefaultTokenCacheTTL
+	}
+	if opts.DelegationTokenMaxLifetime == 0 {
+		opts.DelegationTokenMaxLifetime = DefaultDelegationTokenMaxLifetime
+	}
 	s := &securityService{
-		Opts: opts,
+		Opts:  opts,
+		cache: newTokenCache(opts.TokenCacheTTL),
 	}
 	return s, nil
 }
 
+// CreateUser creates a user, or a service account if ServiceAccount is set.
+// Service accounts must not have a password.
 func (s *securityService) CreateUser(user User) error {
+	if user.ServiceAccount && user.Password != "" {
+		return fmt.Errorf("service account %s must not have a password", user.Name)
+	}
+
+	if user.Password != "" {
+		err := s.Opts.PasswordPolicy.Check(user.Password)
+		if err != nil {
+			return err
+		}
+
+		err = setPassword(&user, user.Password, s.Opts.PasswordIterations)
+		if err != nil {
+			return fmt.Errorf("could not hash password: %w", err)
+		}
+		user.Password = ""
+	}
+
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if user already exists
 		existingUser := User{}
-		tx.Where("name = ?", user.Name).First(&existingUser)
-		if tx.Error != nil && tx.RowsAffected > 0 {
+		result := tx.Where("name = ?", user.Name).Limit(1).Find(&existingUser)
+		if result.Error != nil {
+			return fmt.Errorf("could not check user %s: %w", user.Name, result.Error)
+		}
+		if result.RowsAffected > 0 {
 			return fmt.Errorf("user %s already exists", user.Name)
 		}
 
 		// Create user
-		tx.Create(&user)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to create user: %w", tx.Error)
+		err := tx.Create(&user).Error
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
 		}
 
 		return nil
@@ -120,15 +258,35 @@ func (s *securityService) DeleteUser(userName string) error {
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if user exists
 		user := User{}
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		err = tx.Model(&user).Association("Groups").Clear()
+		if err != nil {
+			return fmt.Errorf("failed to remove user from groups: %w", err)
+		}
+
+		err = tx.Where("user_name = ?", user.Name).Delete(&Token{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete tokens of user: %w", err)
+		}
+
+		err = tx.Where("user_name = ?", user.Name).Delete(&APIKey{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete api keys of user: %w", err)
+		}
+
+		err = tx.Where("user_name = ?", user.Name).Delete(&PasswordHistory{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete password history of user: %w", err)
 		}
 
 		// Delete user
-		tx.Delete(&user)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to delete user: %w", tx.Error)
+		err = tx.Delete(&user).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
 		}
 
 		return nil
@@ -138,6 +296,8 @@ func (s *securityService) DeleteUser(userName string) error {
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.removeUser(userName)
+
 	return nil
 }
 
@@ -145,9 +305,9 @@ func (s *securityService) GetUser(userName string) (User, error) {
 	user := User{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if user exists
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err := tx.Preload("Groups").Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
 		}
 
 		return nil
@@ -163,9 +323,9 @@ func (s *securityService) GetAllUsers() ([]User, error) {
 	users := []User{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Get all users
-		tx.Find(&users)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to get users: %w", tx.Error)
+		err := tx.Preload("Groups").Find(&users).Error
+		if err != nil {
+			return fmt.Errorf("failed to get users: %w", err)
 		}
 
 		return nil
@@ -181,9 +341,9 @@ func (s *securityService) GetGroup(groupName string) (Group, error) {
 	group := Group{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if group exists
-		tx.Where("name = ?", groupName).First(&group)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("group %s not found", groupName)
+		err := tx.Preload("Users").Where("name = ?", groupName).First(&group).Error
+		if err != nil {
+			return fmt.Errorf("group %s not found: %w", groupName, err)
 		}
 
 		return nil
@@ -199,9 +359,9 @@ func (s *securityService) GetAllGroups() ([]Group, error) {
 	groups := []Group{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Get all groups
-		tx.Find(&groups)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to get groups: %w", tx.Error)
+		err := tx.Preload("Users").Find(&groups).Error
+		if err != nil {
+			return fmt.Errorf("failed to get groups: %w", err)
 		}
 
 		return nil
@@ -217,15 +377,18 @@ func (s *securityService) CreateGroup(group Group) error {
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if group already exists
 		existingGroup := Group{}
-		tx.Where("name = ?", group.Name).First(&existingGroup)
-		if tx.Error != nil && tx.RowsAffected > 0 {
+		result := tx.Where("name = ?", group.Name).Limit(1).Find(&existingGroup)
+		if result.Error != nil {
+			return fmt.Errorf("could not check group %s: %w", group.Name, result.Error)
+		}
+		if result.RowsAffected > 0 {
 			return fmt.Errorf("group %s already exists", group.Name)
 		}
 
 		// Create group
-		tx.Create(&group)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to create group: %w", tx.Error)
+		err := tx.Create(&group).Error
+		if err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
 		}
 
 		return nil
@@ -241,15 +404,20 @@ func (s *securityService) DeleteGroup(groupName string) error {
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if group exists
 		group := Group{}
-		tx.Where("name = ?", groupName).First(&group)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("group %s not found", groupName)
+		err := tx.Where("name = ?", groupName).First(&group).Error
+		if err != nil {
+			return fmt.Errorf("group %s not found: %w", groupName, err)
+		}
+
+		err = tx.Model(&group).Association("Users").Clear()
+		if err != nil {
+			return fmt.Errorf("failed to remove users from group: %w", err)
 		}
 
 		// Delete group
-		tx.Delete(&group)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to delete group: %w", tx.Error)
+		err = tx.Delete(&group).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
 		}
 
 		return nil
@@ -258,6 +426,9 @@ func (s *securityService) DeleteGroup(groupName string) error {
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	// Cached users carry their groups
+	s.cache.clear()
+
 	return nil
 }
 
@@ -265,19 +436,19 @@ func (s *securityService) AddUserToGroup(userName string, groupName string) erro
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if group exists
 		group := Group{}
-		tx.Where("name = ?", groupName).First(&group)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("group %s not found", groupName)
+		err := tx.Where("name = ?", groupName).First(&group).Error
+		if err != nil {
+			return fmt.Errorf("group %s not found: %w", groupName, err)
 		}
 
 		// Check if user exists
 		user := User{}
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err = tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
 		}
 
-		err := tx.Model(&group).Association("Users").Append(&user)
+		err = tx.Model(&group).Association("Users").Append(&user)
 		if err != nil {
 			return fmt.Errorf("failed to add user to group: %w", err)
 		}
@@ -288,6 +459,8 @@ func (s *securityService) AddUserToGroup(userName string, groupName string) erro
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.removeUser(userName)
+
 	return nil
 }
 
@@ -295,19 +468,19 @@ func (s *securityService) RemoveUserFromGroup(userName string, groupName string)
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if group exists
 		group := Group{}
-		tx.Where("name = ?", groupName).First(&group)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("group %s not found", groupName)
+		err := tx.Where("name = ?", groupName).First(&group).Error
+		if err != nil {
+			return fmt.Errorf("group %s not found: %w", groupName, err)
 		}
 
 		// Check if user exists
 		user := User{}
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err = tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
 		}
 
-		err := tx.Model(&group).Association("Users").Delete(&user)
+		err = tx.Model(&group).Association("Users").Delete(&user)
 		if err != nil {
 			return fmt.Errorf("failed to remove user from group: %w", err)
 		}
@@ -318,38 +491,250 @@ func (s *securityService) RemoveUserFromGroup(userName string, groupName string)
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.removeUser(userName)
+
 	return nil
 }
 
+// AuthenticateUser verifies the password of a local user and issues a token.
+// Users that have to change their password are refused.
 func (s *securityService) AuthenticateUser(userName string, password string) (string, error) {
-	var t string
+	user, err := s.VerifyPassword(userName, password)
+	if err != nil {
+		return "", err
+	}
+
+	if user.MustChangePassword {
+		return "", fmt.Errorf("user %s: %w", userName, ErrPasswordChangeRequired)
+	}
+
+	return s.IssueToken(user)
+}
+
+// errInvalidPassword tells failed logins from other failures of
+// VerifyPassword.
+var errInvalidPassword = errors.New("invalid password")
+
+// VerifyPassword checks the password of a local user and returns the user
+// with its groups. Locked users and users still in the backoff after a failed
+// login are refused without checking the password.
+func (s *securityService) VerifyPassword(userName string, password string) (User, error) {
+	user := User{}
+	now := time.Now()
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Groups").Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, ErrUnknownUser)
+		}
+
+		if user.Provider != "" {
+			return fmt.Errorf("user %s is authenticated by %s: %w", userName, user.Provider, ErrUnknownUser)
+		}
+
+		if user.ServiceAccount {
+			return fmt.Errorf("user %s is a service account and authenticates with api keys", userName)
+		}
+
+		if user.LockedUntil != nil && now.Before(*user.LockedUntil) {
+			return fmt.Errorf("user %s is locked until %s: %w", userName, user.LockedUntil.Format(time.RFC3339), ErrLoginThrottled)
+		}
+
+		if user.LastFailedLoginAt != nil {
+			wait := user.LastFailedLoginAt.Add(s.Opts.Lockout.backoff(user.FailedLogins)).Sub(now)
+			if wait > 0 {
+				return fmt.Errorf("login of user %s is delayed for %s: %w", userName, wait.Round(time.Second), ErrLoginThrottled)
+			}
+		}
+
+		if !verifyPassword(user, password) {
+			return fmt.Errorf("%w for user %s", errInvalidPassword, userName)
+		}
+
+		if user.FailedLogins > 0 || user.LockedUntil != nil {
+			err := tx.Model(&user).UpdateColumns(map[string]interface{}{
+				"failed_logins":        0,
+				"last_failed_login_at": nil,
+				"locked_until":         nil,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("failed to reset failed logins of user %s: %w", userName, err)
+			}
+		}
+
+		// Upgrade passwords stored in plain text or with too few iterations
+		if user.Iterations < s.Opts.PasswordIterations {
+			err := setPassword(&user, password, s.Opts.PasswordIterations)
+			if err != nil {
+				return fmt.Errorf("could not hash password: %w", err)
+			}
+
+			err = tx.Model(&user).UpdateColumns(map[string]interface{}{
+				"hp":         user.HashedPassword,
+				"salt":       user.Salt,
+				"iterations": user.Iterations,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("failed to upgrade password of user %s: %w", userName, err)
+			}
+
+			s.Opts.Logger.WithField("user", userName).Info("Upgraded stored password hash")
+		}
+
+		return nil
+	})
+	if errors.Is(err, errInvalidPassword) {
+		s.recordLoginFailure(userName, now)
+	}
+	if err != nil {
+		return User{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return user, nil
+}
+
+// recordLoginFailure counts a failed login of a user and locks the user when
+// the lockout policy says so.
+func (s *securityService) recordLoginFailure(userName string, now time.Time) {
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		user := User{}
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		columns := map[string]interface{}{
+			"failed_logins":        user.FailedLogins + 1,
+			"last_failed_login_at": now,
+		}
+
+		if s.Opts.Lockout.MaxFailures > 0 && user.FailedLogins+1 >= s.Opts.Lockout.MaxFailures {
+			columns["failed_logins"] = 0
+			columns["last_failed_login_at"] = nil
+			columns["locked_until"] = now.Add(s.Opts.Lockout.Duration)
+
+			s.Opts.Logger.WithFields(logrus.Fields{
+				"user":     userName,
+				"failures": user.FailedLogins + 1,
+			}).Warn("Locked user after too many failed logins")
+		}
+
+		return tx.Model(&user).UpdateColumns(columns).Error
+	})
+	if err != nil {
+		s.Opts.Logger.WithError(err).WithField("user", userName).Error("Failed to record failed login")
+	}
+}
+
+// UnlockUser lifts the lock of a user and forgets its failed logins.
+func (s *securityService) UnlockUser(userName string) error {
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		user := User{}
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		err = tx.Model(&user).UpdateColumns(map[string]interface{}{
+			"failed_logins":        0,
+			"last_failed_login_at": nil,
+			"locked_until":         nil,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to unlock user: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+// ExpirePassword makes a local user change the password on the next login.
+func (s *securityService) ExpirePassword(userName string) error {
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		user := User{}
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		if user.Provider != "" || user.ServiceAccount {
+			return fmt.Errorf("user %s has no password", userName)
+		}
+
+		err = tx.Model(&user).UpdateColumn("must_change_password", true).Error
+		if err != nil {
+			return fmt.Errorf("failed to expire password: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+// IssueToken creates a token for an authenticated user. Users of other
+// providers are created on their first login and get the groups the
+// provider reported, creating missing groups.
+func (s *securityService) IssueToken(user User) (string, error) {
+	var t string
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		existing := User{}
+		result := tx.Where("name = ?", user.Name).Limit(1).Find(&existing)
+		if result.Error != nil {
+			return fmt.Errorf("could not check user %s: %w", user.Name, result.Error)
+		}
+
+		if result.RowsAffected > 0 && existing.Provider != user.Provider {
+			return fmt.Errorf("user %s is not authenticated by provider '%s'", user.Name, user.Provider)
 		}
 
-		if user.HashedPassword != password {
-			return fmt.Errorf("invalid password for user %s", userName)
+		if user.Provider != "" {
+			if result.RowsAffected == 0 {
+				existing = User{Name: user.Name, Provider: user.Provider}
+				err := tx.Create(&existing).Error
+				if err != nil {
+					return fmt.Errorf("failed to create user %s: %w", user.Name, err)
+				}
+			}
+
+			var groups []*Group
+			for _, providedGroup := range user.Groups {
+				group := Group{}
+				err := tx.Where(Group{Name: providedGroup.Name}).FirstOrCreate(&group).Error
+				if err != nil {
+					return fmt.Errorf("failed to create group %s: %w", providedGroup.Name, err)
+				}
+				groups = append(groups, &group)
+			}
+
+			err := tx.Model(&existing).Association("Groups").Replace(groups)
+			if err != nil {
+				return fmt.Errorf("failed to update groups of user %s: %w", user.Name, err)
+			}
+		} else if result.RowsAffected == 0 {
+			return fmt.Errorf("user %s not found", user.Name)
 		}
 
-		// Generate a token
-		tokenBytes := make([]byte, 1024)
-		_, err := rand.Read(tokenBytes)
+		tokenString, err := newToken()
 		if err != nil {
-			return fmt.Errorf("could not create token: %w", err)
+			return err
 		}
-		tokenString := base64.StdEncoding.EncodeToString(tokenBytes)
 		token := Token{
-			Value:     tokenString,
-			User:      user,
+			Value:     hashToken(tokenString),
+			UserName:  existing.Name,
 			ExpiresAt: time.Now().Add(s.Opts.TokenExperiation),
 		}
 
-		tx.Create(&token)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to create token: %w", tx.Error)
+		err = tx.Create(&token).Error
+		if err != nil {
+			return fmt.Errorf("failed to create token: %w", err)
 		}
 
 		t = tokenString
@@ -360,23 +745,194 @@ func (s *securityService) AuthenticateUser(userName string, password string) (st
 		return "", fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.removeUser(user.Name)
+
 	return t, nil
 }
 
-func (s *securityService) ChangeUserPassword(userName string, newPassword string) error {
+// IssueDelegationToken creates a token of a user restricted to scope that
+// expires after lifetime, which must not exceed the one of login tokens. The
+// renewer, if any, may extend it until the max lifetime of delegation tokens
+// passed.
+func (s *securityService) IssueDelegationToken(userName string, scope TokenScope, lifetime time.Duration, renewer string) (string, time.Time, error) {
+	err := scope.Validate()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("scope is invalid: %w", err)
+	}
+	if lifetime <= 0 || lifetime > s.Opts.TokenExperiation {
+		return "", time.Time{}, fmt.Errorf("lifetime must be positive and at most %s", s.Opts.TokenExperiation)
+	}
+
+	var t string
+	now := time.Now()
+	token := Token{
+		UserName:  userName,
+		ExpiresAt: now.Add(lifetime),
+		Scope:     &scope,
+		Renewer:   renewer,
+		Lifetime:  lifetime,
+	}
+	if renewer != "" {
+		maxExpiresAt := now.Add(s.Opts.DelegationTokenMaxLifetime)
+		token.MaxExpiresAt = &maxExpiresAt
+	}
+
+	err = s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		users := []string{userName}
+		if renewer != "" && renewer != userName {
+			users = append(users, renewer)
+		}
+
+		var count int64
+		err := tx.Model(&User{}).Where("name IN ?", users).Count(&count).Error
+		if err != nil {
+			return fmt.Errorf("could not check users: %w", err)
+		}
+		if count != int64(len(users)) {
+			return fmt.Errorf("user %s or renewer %s not found", userName, renewer)
+		}
+
+		tokenString, err := newToken()
+		if err != nil {
+			return err
+		}
+		token.Value = hashToken(tokenString)
+
+		err = tx.Create(&token).Error
+		if err != nil {
+			return fmt.Errorf("failed to create token: %w", err)
+		}
+
+		t = tokenString
+
+		return nil
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return t, token.ExpiresAt, nil
+}
+
+// RenewDelegationToken extends a delegated token by its lifetime from now on,
+// but not beyond its max lifetime, and returns when it expires. Only the
+// renewer of the token may do so.
+func (s *securityService) RenewDelegationToken(renewer string, token string) (time.Time, error) {
+	hash := hashToken(token)
+	tokenEntity := Token{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("value = ?", hash).First(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("could not get token: %w", err)
+		}
+
+		if tokenEntity.Renewer == "" || tokenEntity.Renewer != renewer {
+			return fmt.Errorf("user %s may not renew the token", renewer)
+		}
+
+		if tokenEntity.IsExpired() {
+			return fmt.Errorf("token is expired")
+		}
+
+		expiresAt := time.Now().Add(tokenEntity.Lifetime)
+		if tokenEntity.MaxExpiresAt != nil && expiresAt.After(*tokenEntity.MaxExpiresAt) {
+			expiresAt = *tokenEntity.MaxExpiresAt
+		}
+		tokenEntity.ExpiresAt = expiresAt
+
+		err = tx.Model(&tokenEntity).UpdateColumn("expired_at", expiresAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to renew token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	s.cache.remove(hash)
+
+	return tokenEntity.ExpiresAt, nil
+}
+
+// ChangeUserPassword sets a new password that satisfies the password policy
+// and was not used recently. It lifts the need to change the password.
+func (s *securityService) ChangeUserPassword(userName string, newPassword string) error {
+	err := s.Opts.PasswordPolicy.Check(newPassword)
+	if err != nil {
+		return err
+	}
+
+	err = s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if user exists
 		user := User{}
-		tx.Where("name = ?", userName).First(&user)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("user %s not found", userName)
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		if user.ServiceAccount {
+			return fmt.Errorf("user %s is a service account and has no password", userName)
+		}
+
+		recent, err := recentPasswords(tx, user, s.Opts.PasswordPolicy.History)
+		if err != nil {
+			return err
+		}
+		for _, password := range recent {
+			if password.HashedPassword != "" && verifyPassword(password, newPassword) {
+				return fmt.Errorf("password of user %s was used recently", userName)
+			}
+		}
+
+		err = rememberPassword(tx, user, s.Opts.PasswordPolicy.History)
+		if err != nil {
+			return err
 		}
 
 		// Update password
-		user.HashedPassword = newPassword
-		tx.Save(&user)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to update user password: %w", tx.Error)
+		err = setPassword(&user, newPassword, s.Opts.PasswordIterations)
+		if err != nil {
+			return fmt.Errorf("could not hash password: %w", err)
+		}
+		user.MustChangePassword = false
+		err = tx.Save(&user).Error
+		if err != nil {
+			return fmt.Errorf("failed to update user password: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+// SetUserUmask sets the umask of files the user creates without permissions.
+// An empty umask makes the user use the one of the server.
+func (s *securityService) SetUserUmask(userName string, umask string) error {
+	umask = strings.TrimSpace(umask)
+	if umask != "" {
+		parsed, err := ParseUmask(umask)
+		if err != nil {
+			return err
+		}
+		umask = parsed.String()
+	}
+
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		user := User{}
+		err := tx.Where("name = ?", userName).First(&user).Error
+		if err != nil {
+			return fmt.Errorf("user %s not found: %w", userName, err)
+		}
+
+		err = tx.Model(&user).UpdateColumn("umask", umask).Error
+		if err != nil {
+			return fmt.Errorf("failed to update user umask: %w", err)
 		}
 
 		return nil
@@ -385,22 +941,25 @@ func (s *securityService) ChangeUserPassword(userName string, newPassword string
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.removeUser(userName)
+
 	return nil
 }
 
 func (s *securityService) Logout(token string) error {
+	hash := hashToken(token)
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if token exists
 		tokenEntity := Token{}
-		tx.Where("value = ?", token).First(&tokenEntity)
-		if tx.Error != nil && tx.RowsAffected == 0 {
-			return fmt.Errorf("token %s not found", token)
+		err := tx.Where("value = ?", hash).First(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("token not found: %w", err)
 		}
 
 		// Delete token
-		tx.Delete(&tokenEntity)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to delete token: %w", tx.Error)
+		err = tx.Delete(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete token: %w", err)
 		}
 
 		return nil
@@ -409,60 +968,132 @@ func (s *securityService) Logout(token string) error {
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
+	s.cache.remove(hash)
+
 	return nil
 }
 
 func (s *securityService) LookupUserByToken(token string) (User, error) {
-	user := User{}
+	hash := hashToken(token)
+	user, ok := s.cache.get(hash)
+	if ok {
+		return user, nil
+	}
+
+	if isAPIKey(token) {
+		user, expiresAt, err := s.lookupUserByAPIKey(hash)
+		if err != nil {
+			return User{}, err
+		}
+
+		s.cache.put(hash, user, expiresAt)
+
+		return user, nil
+	}
+
+	tokenEntity := Token{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
 		// Check if token exists
-		tokenEntity := Token{}
-		tx.Where("value = ?", token).First(&tokenEntity)
-		if tx.Error != nil {
-			return fmt.Errorf("could not get token: %w", tx.Error)
+		err := tx.Preload("User.Groups").Where("value = ?", hash).First(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("could not get token: %w", err)
 		}
 
 		if tokenEntity.IsExpired() {
 			return fmt.Errorf("token is expired")
 		}
 
-		user = tokenEntity.User
-
 		return nil
 	}, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
 		return User{}, fmt.Errorf("transaction failed: %w", err)
 	}
 
+	user = tokenEntity.User
+	user.Scope = tokenEntity.Scope
+
+	s.cache.put(hash, user, tokenEntity.ExpiresAt)
+
 	return user, nil
 }
 
-func (s *securityService) IsTokenValid(token string, userName string) bool {
-	var valid bool = false
+// GetSessions returns the tokens of a user that have not expired yet, oldest
+// first. The stored values are hashes and can not be used to log in.
+func (s *securityService) GetSessions(userName string) ([]Token, error) {
+	tokens := []Token{}
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_name = ? AND expired_at > ?", userName, time.Now()).Order("created_at").Find(&tokens).Error
+		if err != nil {
+			return fmt.Errorf("failed to get tokens of user %s: %w", userName, err)
+		}
+
+		return nil
+	}, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
 
+	return tokens, nil
+}
+
+func (s *securityService) RevokeSession(userName string, id uint64) error {
+	tokenEntity := Token{}
 	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
-		// Check if token exists
-		tokenEntity := Token{}
-		tx.Where("value = ? AND user_id = (SELECT id FROM users WHERE name = ?)", token, userName).First(&tokenEntity)
-		if tx.Error != nil {
-			return fmt.Errorf("failed to check token: %w", tx.Error)
+		err := tx.Where("id = ? AND user_name = ?", id, userName).First(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("session %d of user %s not found: %w", id, userName, err)
 		}
 
-		if tx.RowsAffected == 0 {
-			valid = false
-			return nil
+		err = tx.Delete(&tokenEntity).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	s.cache.remove(tokenEntity.Value)
+
+	return nil
+}
+
+func (s *securityService) RevokeAllSessions(userName string) error {
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_name = ?", userName).Delete(&Token{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete tokens of user %s: %w", userName, err)
 		}
 
-		if !tokenEntity.IsExpired() {
-			valid = true
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	s.cache.removeUser(userName)
+
+	return nil
+}
+
+// PurgeExpiredTokens deletes the expired tokens and returns how many were
+// deleted.
+func (s *securityService) PurgeExpiredTokens() (int64, error) {
+	var deleted int64
+	err := s.Opts.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("expired_at <= ?", time.Now()).Delete(&Token{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete expired tokens: %w", result.Error)
 		}
+		deleted = result.RowsAffected
 
 		return nil
-	}, &sql.TxOptions{ReadOnly: true})
+	})
 	if err != nil {
-		s.Opts.Logger.WithError(err).Error("failed to check token")
-		return false
+		return 0, fmt.Errorf("transaction failed: %w", err)
 	}
 
-	return valid
+	return deleted, nil
 }
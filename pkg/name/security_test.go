@@ -21,6 +21,8 @@ func createSecurityDB(t *testing.T) *gorm.DB {
 		name.User{},
 		name.Group{},
 		name.Token{},
+		name.APIKey{},
+		name.PasswordHistory{},
 		name.Permissions{},
 		name.Permission{})
 	assert.NoError(t, err)
@@ -40,3 +42,218 @@ func TestNewSecurityService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, service)
 }
+
+func createSecurityService(t *testing.T, db *gorm.DB) name.SecurityService {
+	opts := name.SecurityServiceOpts{
+		Logger:             logrus.New(),
+		DB:                 db,
+		TokenExperiation:   1 * time.Hour,
+		PasswordIterations: 1000,
+	}
+	service, err := name.NewSecurityService(opts)
+	assert.NoError(t, err)
+
+	return service
+}
+
+func TestSecurityService_HashedPassword(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := service.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	user, err := service.GetUser("alice")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "secret", user.HashedPassword)
+	assert.NotEmpty(t, user.Salt)
+	assert.Equal(t, 1000, user.Iterations)
+
+	token, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	_, err = service.AuthenticateUser("alice", "wrong")
+	assert.Error(t, err)
+
+	err = service.ChangeUserPassword("alice", "changed")
+	assert.NoError(t, err)
+
+	changed, err := service.GetUser("alice")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "changed", changed.HashedPassword)
+	assert.NotEqual(t, user.Salt, changed.Salt)
+
+	_, err = service.AuthenticateUser("alice", "secret")
+	assert.Error(t, err)
+
+	_, err = service.AuthenticateUser("alice", "changed")
+	assert.NoError(t, err)
+}
+
+func TestSecurityService_MigrateLegacyPassword(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := db.Create(&name.User{Name: "bob", HashedPassword: "legacy"}).Error
+	assert.NoError(t, err)
+
+	_, err = service.AuthenticateUser("bob", "wrong")
+	assert.Error(t, err)
+
+	user, err := service.GetUser("bob")
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy", user.HashedPassword)
+	assert.Equal(t, 0, user.Iterations)
+
+	_, err = service.AuthenticateUser("bob", "legacy")
+	assert.NoError(t, err)
+
+	user, err = service.GetUser("bob")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "legacy", user.HashedPassword)
+	assert.NotEmpty(t, user.Salt)
+	assert.Equal(t, 1000, user.Iterations)
+
+	_, err = service.AuthenticateUser("bob", "legacy")
+	assert.NoError(t, err)
+}
+
+func TestSecurityService_HashedToken(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := service.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	token, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	var stored name.Token
+	err = db.Where("user_name = ?", "alice").First(&stored).Error
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, stored.Value)
+	assert.Len(t, stored.Value, 64)
+
+	user, err := service.LookupUserByToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", user.Name)
+
+	_, err = service.LookupUserByToken(stored.Value)
+	assert.Error(t, err)
+
+	err = service.Logout(token)
+	assert.NoError(t, err)
+
+	_, err = service.LookupUserByToken(token)
+	assert.Error(t, err)
+}
+
+func TestSecurityService_Sessions(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := service.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+	err = service.CreateUser(name.User{Name: "bob", Password: "secret"})
+	assert.NoError(t, err)
+
+	first, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	second, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	third, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	other, err := service.AuthenticateUser("bob", "secret")
+	assert.NoError(t, err)
+
+	// Fill the cache before revoking
+	for _, token := range []string{first, second, third, other} {
+		_, err = service.LookupUserByToken(token)
+		assert.NoError(t, err)
+	}
+
+	sessions, err := service.GetSessions("alice")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 3)
+
+	bobSessions, err := service.GetSessions("bob")
+	assert.NoError(t, err)
+	assert.Len(t, bobSessions, 1)
+
+	err = service.RevokeSession("alice", bobSessions[0].ID)
+	assert.Error(t, err)
+
+	err = service.RevokeSession("alice", sessions[0].ID)
+	assert.NoError(t, err)
+
+	_, err = service.LookupUserByToken(first)
+	assert.Error(t, err)
+	_, err = service.LookupUserByToken(second)
+	assert.NoError(t, err)
+
+	err = service.RevokeAllSessions("alice")
+	assert.NoError(t, err)
+
+	_, err = service.LookupUserByToken(second)
+	assert.Error(t, err)
+	_, err = service.LookupUserByToken(third)
+	assert.Error(t, err)
+	_, err = service.LookupUserByToken(other)
+	assert.NoError(t, err)
+
+	sessions, err = service.GetSessions("alice")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestSecurityService_PurgeExpiredTokens(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := service.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	token, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+	_, err = service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	err = db.Model(&name.Token{}).Where("id = ?", 1).Update("expired_at", time.Now().Add(-time.Minute)).Error
+	assert.NoError(t, err)
+
+	deleted, err := service.PurgeExpiredTokens()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	sessions, err := service.GetSessions("alice")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	_, err = service.LookupUserByToken(token)
+	assert.Error(t, err)
+}
+
+func TestSecurityService_GroupChangeInvalidatesCache(t *testing.T) {
+	db := createSecurityDB(t)
+	service := createSecurityService(t, db)
+
+	err := service.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+	err = service.CreateGroup(name.Group{Name: "staff"})
+	assert.NoError(t, err)
+
+	token, err := service.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	user, err := service.LookupUserByToken(token)
+	assert.NoError(t, err)
+	assert.Empty(t, user.Groups)
+
+	err = service.AddUserToGroup("alice", "staff")
+	assert.NoError(t, err)
+
+	user, err = service.LookupUserByToken(token)
+	assert.NoError(t, err)
+	assert.Len(t, user.Groups, 1)
+}
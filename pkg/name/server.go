@@ -2,15 +2,35 @@ package name
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+	"time"
 )
 
+const DefaultBlockTokenExpiration = 10 * time.Minute
+
 type ServerOpts struct {
 	Logger          *logrus.Logger
 	SecurityService SecurityService
 	FileService     FileService
+	// Authenticator verifies the credentials of Login. The local users are
+	// authenticated by the SecurityService when nil.
+	Authenticator Authenticator
+	// BlockKeys signs the block access tokens handed out with block
+	// locations. No tokens are issued when nil.
+	BlockKeys            *blocktoken.Keyring
+	BlockTokenExpiration time.Duration
+	// SuperuserGroup is the group whose members have root privileges.
+	SuperuserGroup string
+	// LoginLimiter delays logins from client IPs after failed ones. Logins
+	// are only throttled per user when nil.
+	LoginLimiter *LoginLimiter
 }
 
 type Server struct {
@@ -18,13 +38,60 @@ type Server struct {
 	Opts ServerOpts
 }
 
+// Login issues a token for valid credentials. Local users that have to
+// change their password, or want to, send the new one along.
 func (s Server) Login(ctx context.Context, request *proto.LoginRequest) (*proto.LoginResponse, error) {
-	token, err := s.Opts.SecurityService.AuthenticateUser(request.GetUser(), request.GetHashedPassword())
+	authenticator := s.Opts.Authenticator
+	if authenticator == nil {
+		authenticator = NewDBAuthenticator(s.Opts.SecurityService)
+	}
+
+	ip := clientIP(ctx)
+	if s.Opts.LoginLimiter != nil {
+		err := s.Opts.LoginLimiter.Allow(ip)
+		if err != nil {
+			return nil, status.Errorf(codes.ResourceExhausted, "login failed: %v", err)
+		}
+	}
+
+	user, err := authenticator.Authenticate(Credentials{
+		User:     request.GetUser(),
+		Password: request.GetHashedPassword(),
+	})
+	if errors.Is(err, ErrLoginThrottled) {
+		return nil, status.Errorf(codes.ResourceExhausted, "login failed: %v", err)
+	}
+	if err != nil {
+		if s.Opts.LoginLimiter != nil {
+			s.Opts.LoginLimiter.Failure(ip)
+		}
+		return nil, fmt.Errorf("login failed: %w", err)
+	}
+
+	if s.Opts.LoginLimiter != nil {
+		s.Opts.LoginLimiter.Success(ip)
+	}
+
+	if request.GetNewPassword() != "" {
+		if user.Provider != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "login failed: password of user %s is managed by %s", user.Name, user.Provider)
+		}
+
+		err = s.Opts.SecurityService.ChangeUserPassword(user.Name, request.GetNewPassword())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "login failed: could not change password: %v", err)
+		}
+	} else if user.MustChangePassword {
+		return nil, status.Errorf(codes.FailedPrecondition, "login failed: user %s: %v", user.Name, ErrPasswordChangeRequired)
+	}
+
+	token, err := s.Opts.SecurityService.IssueToken(user)
 	if err != nil {
 		return nil, fmt.Errorf("login failed: %w", err)
 	}
 
 	return &proto.LoginResponse{
+		User:  user.Name,
 		Token: token,
 	}, nil
 }
@@ -38,10 +105,18 @@ func (s Server) Logout(ctx context.Context, request *proto.LogoutRequest) (*prot
 	return &proto.LogoutResponse{}, nil
 }
 
+// principal returns the principal of the caller, preferring the bearer token
+// resolved by the AuthInterceptor over the deprecated token request field.
+func (s Server) principal(ctx context.Context, token string) (Principal, error) {
+	return resolvePrincipal(ctx, s.Opts.Logger, s.Opts.SecurityService, token)
+}
+
 func convertProtoPermission(permission *proto.Permission) Permission {
 	return Permission{
-		Read:  permission.GetRead(),
-		Write: permission.GetWrite(),
+		Read:    permission.GetRead(),
+		Write:   permission.GetWrite(),
+		Delete:  permission.GetDelete(),
+		Execute: permission.GetExecute(),
 	}
 }
 
@@ -52,13 +127,29 @@ func convertProtoPermissions(permissions *proto.Permissions) Permissions {
 		OwnerPermission: convertProtoPermission(permissions.GetOwnerPermission()),
 		GroupPermission: convertProtoPermission(permissions.GetGroupPermission()),
 		OtherPermission: convertProtoPermission(permissions.GetOtherPermission()),
+		Sticky:          permissions.GetSticky(),
+		SetGID:          permissions.GetSetgid(),
 	}
 }
 
+// convertOptionalProtoPermissions returns nil for absent permissions, so that
+// the file service derives them.
+func convertOptionalProtoPermissions(permissions *proto.Permissions) *Permissions {
+	if permissions == nil {
+		return nil
+	}
+
+	converted := convertProtoPermissions(permissions)
+
+	return &converted
+}
+
 func convertToProtoPermission(permission Permission) *proto.Permission {
 	return &proto.Permission{
-		Read:  permission.Read,
-		Write: permission.Write,
+		Read:    permission.Read,
+		Write:   permission.Write,
+		Delete:  permission.Delete,
+		Execute: permission.Execute,
 	}
 }
 
@@ -69,43 +160,52 @@ func convertToProtoPermissions(permissions Permissions) *proto.Permissions {
 		OwnerPermission: convertToProtoPermission(permissions.OwnerPermission),
 		GroupPermission: convertToProtoPermission(permissions.GroupPermission),
 		OtherPermission: convertToProtoPermission(permissions.OtherPermission),
+		Sticky:          permissions.Sticky,
+		Setgid:          permissions.SetGID,
+	}
+}
+
+// quotaError wraps an error of the file service. Exceeded quotas become
+// ResourceExhausted so that clients can tell them from other failures.
+func quotaError(message string, err error) error {
+	if errors.Is(err, ErrQuotaExceeded) {
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", message, err)
 	}
+
+	return fmt.Errorf("%s: %w", message, err)
 }
 
 func (s Server) CreateFile(ctx context.Context, request *proto.CreateFileRequest) (*proto.CreateFileResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
-	permissions := convertProtoPermissions(request.GetPermissions())
+	permissions := convertOptionalProtoPermissions(request.GetPermissions())
 	_, err = s.Opts.FileService.CreateFile(principal, request.GetPath(), permissions)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create file: %w", err)
+		return nil, quotaError("failed to create file", err)
 	}
 	return &proto.CreateFileResponse{}, nil
 }
 
 func (s Server) CreateDir(ctx context.Context, request *proto.CreateDirRequest) (*proto.CreateDirResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
-	permissions := convertProtoPermissions(request.GetPermissions())
+	permissions := convertOptionalProtoPermissions(request.GetPermissions())
 	_, err = s.Opts.FileService.CreateDir(principal, request.GetPath(), permissions)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create dir: %w", err)
+		return nil, quotaError("failed to create dir", err)
 	}
 	return &proto.CreateDirResponse{}, nil
 }
 
 func (s Server) DeleteFile(ctx context.Context, request *proto.DeleteFileRequest) (*proto.DeleteFileResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
 	err = s.Opts.FileService.DeleteFile(principal, request.GetPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete file: %w", err)
@@ -114,11 +214,10 @@ func (s Server) DeleteFile(ctx context.Context, request *proto.DeleteFileRequest
 }
 
 func (s Server) DeleteDir(ctx context.Context, request *proto.DeleteDirRequest) (*proto.DeleteDirResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
 	err = s.Opts.FileService.DeleteDir(principal, request.GetPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete dir: %w", err)
@@ -126,6 +225,104 @@ func (s Server) DeleteDir(ctx context.Context, request *proto.DeleteDirRequest)
 	return &proto.DeleteDirResponse{}, nil
 }
 
+func (s Server) SetPermission(ctx context.Context, request *proto.SetPermissionRequest) (*proto.SetPermissionResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	err = s.Opts.FileService.SetPermission(
+		principal,
+		request.GetPath(),
+		convertProtoPermission(request.GetOwnerPermission()),
+		convertProtoPermission(request.GetGroupPermission()),
+		convertProtoPermission(request.GetOtherPermission()),
+		request.GetSticky(),
+		request.GetSetgid(),
+		request.GetRecursive())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set permission: %w", err)
+	}
+	return &proto.SetPermissionResponse{}, nil
+}
+
+func (s Server) SetOwner(ctx context.Context, request *proto.SetOwnerRequest) (*proto.SetOwnerResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	err = s.Opts.FileService.SetOwner(principal, request.GetPath(), request.GetOwner(), request.GetGroup(), request.GetRecursive())
+	if err != nil {
+		return nil, quotaError("failed to set owner", err)
+	}
+	return &proto.SetOwnerResponse{}, nil
+}
+
+func convertProtoACL(entries []*proto.ACLEntry) []ACLEntry {
+	var result []ACLEntry
+
+	for _, entry := range entries {
+		result = append(result, ACLEntry{
+			Type:       entry.GetType(),
+			Name:       entry.GetName(),
+			Permission: convertProtoPermission(entry.GetPermission()),
+			Default:    entry.GetDefault(),
+		})
+	}
+
+	return result
+}
+
+func convertToProtoACL(entries []ACLEntry) []*proto.ACLEntry {
+	var result []*proto.ACLEntry
+
+	for _, entry := range entries {
+		result = append(result, &proto.ACLEntry{
+			Type:       entry.Type,
+			Name:       entry.Name,
+			Permission: convertToProtoPermission(entry.Permission),
+			Default:    entry.Default,
+		})
+	}
+
+	return result
+}
+
+func (s Server) GetACL(ctx context.Context, request *proto.GetACLRequest) (*proto.GetACLResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.Opts.FileService.GetACL(principal, request.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get acl: %w", err)
+	}
+	return &proto.GetACLResponse{Entries: convertToProtoACL(entries)}, nil
+}
+
+func (s Server) SetACL(ctx context.Context, request *proto.SetACLRequest) (*proto.SetACLResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	err = s.Opts.FileService.SetACL(principal, request.GetPath(), convertProtoACL(request.GetEntries()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set acl: %w", err)
+	}
+	return &proto.SetACLResponse{}, nil
+}
+
+func (s Server) RemoveACL(ctx context.Context, request *proto.RemoveACLRequest) (*proto.RemoveACLResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	err = s.Opts.FileService.RemoveACL(principal, request.GetPath(), convertProtoACL(request.GetEntries()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove acl: %w", err)
+	}
+	return &proto.RemoveACLResponse{}, nil
+}
+
 func convertToProtoDirEntryFile(fileInfo FileInfo, parentDir string) *proto.DirEntry {
 	path := fmt.Sprintf("%s/%s", parentDir, fileInfo.Name)
 	return &proto.DirEntry{
@@ -139,11 +336,10 @@ func convertToProtoDirEntryFile(fileInfo FileInfo, parentDir string) *proto.DirE
 }
 
 func (s Server) List(ctx context.Context, request *proto.ListRequest) (*proto.ListResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
 
 	fileInfos, err := s.Opts.FileService.List(principal, request.GetPath())
 	if err != nil {
@@ -162,21 +358,44 @@ func (s Server) List(ctx context.Context, request *proto.ListRequest) (*proto.Li
 	}, nil
 }
 
-func convertToProtoStatBlockInfo(blockInfo BlockInfo) *proto.StatBlockInfo {
+func convertToProtoStatBlockInfo(blockInfo BlockInfo, accessToken string) *proto.StatBlockInfo {
 	return &proto.StatBlockInfo{
-		BlockId:  blockInfo.ID,
-		Crc:      blockInfo.CRC,
-		Sequence: blockInfo.Sequence,
-		Length:   blockInfo.Length,
+		BlockId:     blockInfo.ID,
+		Crc:         blockInfo.CRC,
+		Sequence:    blockInfo.Sequence,
+		Length:      blockInfo.Length,
+		AccessToken: accessToken,
+	}
+}
+
+// issueBlockToken returns a block access token for the nodes or an empty
+// token when no block keys are configured.
+func (s Server) blockTokenExpiration() time.Duration {
+	if s.Opts.BlockTokenExpiration == 0 {
+		return DefaultBlockTokenExpiration
+	}
+
+	return s.Opts.BlockTokenExpiration
+}
+
+func (s Server) issueBlockToken(blockID string, path string, ops blocktoken.Op, length uint64) (string, error) {
+	if s.Opts.BlockKeys == nil {
+		return "", nil
+	}
+
+	token, err := s.Opts.BlockKeys.Issue(blockID, path, ops, length, s.blockTokenExpiration())
+	if err != nil {
+		return "", fmt.Errorf("failed to issue block access token: %w", err)
 	}
+
+	return token, nil
 }
 
 func (s Server) Stat(ctx context.Context, request *proto.StatRequest) (*proto.StatResponse, error) {
-	user, err := s.Opts.SecurityService.LookupUserByToken(request.GetToken())
+	principal, err := s.principal(ctx, request.GetToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to lookup user: %w", err)
+		return nil, err
 	}
-	principal := NewPrincipal(user)
 	fileInfo, err := s.Opts.FileService.Stat(principal, request.GetPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to stat file '%s': %w", request.GetPath(), err)
@@ -190,7 +409,11 @@ func (s Server) Stat(ctx context.Context, request *proto.StatRequest) (*proto.St
 	var protoBlockInfos []*proto.StatBlockInfo
 
 	for _, blockInfo := range blockInfos {
-		protoBlockInfos = append(protoBlockInfos, convertToProtoStatBlockInfo(blockInfo))
+		accessToken, err := s.issueBlockToken(blockInfo.ID, request.GetPath(), blocktoken.OpRead, 0)
+		if err != nil {
+			return nil, err
+		}
+		protoBlockInfos = append(protoBlockInfos, convertToProtoStatBlockInfo(blockInfo, accessToken))
 	}
 
 	return &proto.StatResponse{
@@ -199,3 +422,274 @@ func (s Server) Stat(ctx context.Context, request *proto.StatRequest) (*proto.St
 		BlockInfos: protoBlockInfos,
 	}, nil
 }
+
+// GetBlockAccessToken issues a token for the operations on a block of the
+// file at path. Reading and deleting require the block to belong to the file,
+// writing also allows new blocks. The length of a new block is reserved in the
+// quotas, so that a block exceeding them is never stored.
+func (s Server) GetBlockAccessToken(ctx context.Context, request *proto.GetBlockAccessTokenRequest) (*proto.GetBlockAccessTokenResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Opts.BlockKeys == nil {
+		return nil, status.Error(codes.Unavailable, "block access tokens are not configured")
+	}
+
+	var ops blocktoken.Op
+	if request.GetRead() {
+		ops |= blocktoken.OpRead
+	}
+	if request.GetWrite() {
+		ops |= blocktoken.OpWrite
+	}
+	if request.GetDelete() {
+		ops |= blocktoken.OpDelete
+	}
+	if ops == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no operation requested")
+	}
+
+	fileInfo, err := s.Opts.FileService.Stat(principal, request.GetPath())
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to stat file '%s': %v", request.GetPath(), err)
+	}
+
+	if fileInfo.IsDir {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is a directory", request.GetPath())
+	}
+
+	privileges := principal.ComputePrivileges(&fileInfo)
+	if IsSuperuser(principal, s.Opts.SuperuserGroup) {
+		privileges = Privileges{Read: true, Write: true, Delete: true, Execute: true}
+	}
+	if scope := principal.Scope(); scope != nil {
+		privileges = scope.Limit(request.GetPath(), privileges)
+	}
+	if ops&blocktoken.OpWrite != 0 && !privileges.Write {
+		return nil, status.Errorf(codes.PermissionDenied, "no write permission for '%s'", request.GetPath())
+	}
+	if ops&blocktoken.OpDelete != 0 && !privileges.Delete {
+		return nil, status.Errorf(codes.PermissionDenied, "no delete permission for '%s'", request.GetPath())
+	}
+
+	blockInfos, err := s.Opts.FileService.GetBlockInfos(principal, request.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block infos: %w", err)
+	}
+
+	index := slices.IndexFunc(blockInfos, func(blockInfo BlockInfo) bool {
+		return blockInfo.ID == request.GetBlockId()
+	})
+	owned := index >= 0
+	if !owned && ops != blocktoken.OpWrite {
+		return nil, status.Errorf(codes.NotFound, "block %s does not belong to '%s'", request.GetBlockId(), request.GetPath())
+	}
+
+	// nodes store no more than was charged for the block
+	var length uint64
+	if owned {
+		length = uint64(blockInfos[index].Length)
+	} else {
+		if request.GetLength() == 0 {
+			return nil, status.Error(codes.InvalidArgument, "length of the new block is required")
+		}
+
+		err = s.Opts.FileService.ReserveBlock(request.GetPath(), request.GetBlockId(), request.GetLength(), time.Now().Add(s.blockTokenExpiration()))
+		if err != nil {
+			return nil, quotaError(fmt.Sprintf("failed to reserve block %s", request.GetBlockId()), err)
+		}
+		length = request.GetLength()
+	}
+
+	accessToken, err := s.issueBlockToken(request.GetBlockId(), request.GetPath(), ops, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetBlockAccessTokenResponse{AccessToken: accessToken}, nil
+}
+
+// user returns the name of the authenticated caller. Delegated tokens may not
+// act for their user beyond their scope, so they are rejected.
+func (s Server) user(ctx context.Context, token string) (string, error) {
+	principal, err := s.principal(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	if principal.Scope() != nil {
+		return "", status.Error(codes.PermissionDenied, "delegated tokens may not manage tokens")
+	}
+
+	return principal.User(), nil
+}
+
+func (s Server) CreateDelegationToken(ctx context.Context, request *proto.CreateDelegationTokenRequest) (*proto.CreateDelegationTokenResponse, error) {
+	userName, err := s.user(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	scope := TokenScope{
+		Paths:      request.GetPaths(),
+		Operations: Privileges(convertProtoPermission(request.GetOperations())),
+	}
+	lifetime := time.Duration(request.GetLifetime()) * time.Second
+
+	token, expiresAt, err := s.Opts.SecurityService.IssueDelegationToken(userName, scope, lifetime, request.GetRenewer())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create delegation token: %v", err)
+	}
+
+	return &proto.CreateDelegationTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+func (s Server) RenewDelegationToken(ctx context.Context, request *proto.RenewDelegationTokenRequest) (*proto.RenewDelegationTokenResponse, error) {
+	userName, err := s.user(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := s.Opts.SecurityService.RenewDelegationToken(userName, request.GetDelegationToken())
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to renew delegation token: %v", err)
+	}
+
+	return &proto.RenewDelegationTokenResponse{ExpiresAt: expiresAt.Unix()}, nil
+}
+
+func (s Server) ListSessions(ctx context.Context, request *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	userName, err := s.user(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.Opts.SecurityService.GetSessions(userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	var sessions []*proto.Session
+
+	for _, token := range tokens {
+		sessions = append(sessions, &proto.Session{
+			Id:        token.ID,
+			CreatedAt: token.CreatedAt.Unix(),
+			ExpiresAt: token.ExpiresAt.Unix(),
+		})
+	}
+
+	return &proto.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+func (s Server) RevokeSession(ctx context.Context, request *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	userName, err := s.user(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.RevokeSession(userName, request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to revoke session %d: %v", request.GetId(), err)
+	}
+
+	return &proto.RevokeSessionResponse{}, nil
+}
+
+func (s Server) RevokeAllSessions(ctx context.Context, request *proto.RevokeAllSessionsRequest) (*proto.RevokeAllSessionsResponse, error) {
+	userName, err := s.user(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.SecurityService.RevokeAllSessions(userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return &proto.RevokeAllSessionsResponse{}, nil
+}
+
+func (s Server) GetContentSummary(ctx context.Context, request *proto.GetContentSummaryRequest) (*proto.GetContentSummaryResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.Opts.FileService.GetContentSummary(principal, request.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content summary: %w", err)
+	}
+
+	return &proto.GetContentSummaryResponse{
+		Path:          request.GetPath(),
+		DirCount:      summary.Dirs,
+		FileCount:     summary.Files,
+		Length:        summary.Length,
+		SpaceConsumed: summary.SpaceConsumed,
+		Quota: &proto.Quota{
+			Namespace:     summary.Quota.Namespace,
+			NamespaceUsed: summary.Quota.NamespaceUsed,
+			Space:         summary.Quota.Space,
+			SpaceUsed:     summary.Quota.SpaceUsed,
+		},
+	}, nil
+}
+
+func (s Server) SetQuota(ctx context.Context, request *proto.SetQuotaRequest) (*proto.SetQuotaResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.FileService.SetQuota(principal, request.GetPath(), request.GetNamespace(), request.GetSpace())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set quota: %w", err)
+	}
+
+	return &proto.SetQuotaResponse{}, nil
+}
+
+func (s Server) SetUserQuota(ctx context.Context, request *proto.SetUserQuotaRequest) (*proto.SetUserQuotaResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Opts.FileService.SetUserQuota(principal, request.GetUser(), request.GetSpace())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set user quota: %w", err)
+	}
+
+	return &proto.SetUserQuotaResponse{}, nil
+}
+
+// GetUserQuota returns the quota of the given user, or of the caller when
+// none is given.
+func (s Server) GetUserQuota(ctx context.Context, request *proto.GetUserQuotaRequest) (*proto.GetUserQuotaResponse, error) {
+	principal, err := s.principal(ctx, request.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	user := request.GetUser()
+	if user == "" {
+		user = principal.User()
+	}
+
+	userQuota, err := s.Opts.FileService.GetUserQuota(principal, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user quota: %w", err)
+	}
+
+	return &proto.GetUserQuotaResponse{
+		User:      userQuota.UserName,
+		Space:     userQuota.Space,
+		SpaceUsed: userQuota.SpaceUsed,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package name
+
+import (
+	"sync"
+	"time"
+)
+
+type cachedToken struct {
+	user      User
+	expiresAt time.Time
+}
+
+// tokenCache keeps the users of validated tokens, keyed by token hash, so
+// that not every call has to query the database.
+type tokenCache struct {
+	ttl     time.Duration
+	lock    sync.Mutex
+	entries map[string]cachedToken
+}
+
+func newTokenCache(ttl time.Duration) *tokenCache {
+	return &tokenCache{
+		ttl:     ttl,
+		entries: map[string]cachedToken{},
+	}
+}
+
+func (c *tokenCache) get(hash string) (User, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[hash]
+	if !ok {
+		return User{}, false
+	}
+
+	if !time.Now().Before(entry.expiresAt) {
+		delete(c.entries, hash)
+		return User{}, false
+	}
+
+	return entry.user, true
+}
+
+// put caches the user of a token until the TTL passed, but never beyond the
+// expiration of the token.
+func (c *tokenCache) put(hash string, user User, tokenExpiresAt time.Time) {
+	expiresAt := time.Now().Add(c.ttl)
+	if tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Drop expired entries once in a while so that the cache does not grow
+	// with tokens that are not used anymore.
+	if len(c.entries) > 0 && len(c.entries)%1024 == 0 {
+		now := time.Now()
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+	}
+
+	c.entries[hash] = cachedToken{user: user, expiresAt: expiresAt}
+}
+
+func (c *tokenCache) remove(hash string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, hash)
+}
+
+func (c *tokenCache) removeUser(userName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, entry := range c.entries {
+		if entry.user.Name == userName {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *tokenCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries = map[string]cachedToken{}
+}
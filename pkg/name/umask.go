@@ -0,0 +1,88 @@
+package name
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultUmask is the umask of the server unless configured otherwise.
+const DefaultUmask = "022"
+
+// Umask holds the bits that new files and directories created without
+// explicit permissions do not get. It is written in octal like a POSIX umask,
+// where 4 masks read, 2 masks write and delete, and 1 masks execute.
+type Umask struct {
+	Owner Permission
+	Group Permission
+	Other Permission
+}
+
+func ParseUmask(s string) (Umask, error) {
+	if len(s) == 0 || len(s) > 4 {
+		return Umask{}, fmt.Errorf("invalid umask '%s', expected up to 4 octal digits", s)
+	}
+
+	bits, err := strconv.ParseUint(s, 8, 12)
+	if err != nil {
+		return Umask{}, fmt.Errorf("invalid umask '%s': %w", s, err)
+	}
+	if bits > 0777 {
+		return Umask{}, fmt.Errorf("invalid umask '%s', only permission bits can be masked", s)
+	}
+
+	return Umask{
+		Owner: umaskPermission(bits >> 6),
+		Group: umaskPermission(bits >> 3),
+		Other: umaskPermission(bits),
+	}, nil
+}
+
+func umaskPermission(bits uint64) Permission {
+	return Permission{
+		Read:    bits&4 != 0,
+		Write:   bits&2 != 0,
+		Delete:  bits&2 != 0,
+		Execute: bits&1 != 0,
+	}
+}
+
+func umaskBits(permission Permission) int {
+	bits := 0
+	if permission.Read {
+		bits |= 4
+	}
+	if permission.Write || permission.Delete {
+		bits |= 2
+	}
+	if permission.Execute {
+		bits |= 1
+	}
+
+	return bits
+}
+
+func (u Umask) String() string {
+	return fmt.Sprintf("%03o", umaskBits(u.Owner)<<6|umaskBits(u.Group)<<3|umaskBits(u.Other))
+}
+
+func maskPermission(permission Permission, mask Permission) Permission {
+	return Permission{
+		Read:    permission.Read && !mask.Read,
+		Write:   permission.Write && !mask.Write,
+		Delete:  permission.Delete && !mask.Delete,
+		Execute: permission.Execute && !mask.Execute,
+	}
+}
+
+// Apply returns the permissions of a new file or directory, which start with
+// all bits but execute for files, without the masked bits. Owner and group
+// are left empty.
+func (u Umask) Apply(isDir bool) Permissions {
+	full := Permission{Read: true, Write: true, Delete: true, Execute: isDir}
+
+	return Permissions{
+		OwnerPermission: maskPermission(full, u.Owner),
+		GroupPermission: maskPermission(full, u.Group),
+		OtherPermission: maskPermission(full, u.Other),
+	}
+}
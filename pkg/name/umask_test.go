@@ -0,0 +1,162 @@
+package name_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/mocks"
+	"github.com/cirglo.com/dfs/pkg/name"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseUmask(t *testing.T) {
+	tests := []struct {
+		umask    string
+		expected name.Umask
+		valid    bool
+	}{
+		{umask: "022", valid: true, expected: name.Umask{
+			Group: name.Permission{Write: true, Delete: true},
+			Other: name.Permission{Write: true, Delete: true},
+		}},
+		{umask: "0077", valid: true, expected: name.Umask{
+			Group: name.Permission{Read: true, Write: true, Delete: true, Execute: true},
+			Other: name.Permission{Read: true, Write: true, Delete: true, Execute: true},
+		}},
+		{umask: "0", valid: true, expected: name.Umask{}},
+		{umask: "", valid: false},
+		{umask: "089", valid: false},
+		{umask: "1022", valid: false},
+		{umask: "00022", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.umask, func(t *testing.T) {
+			umask, err := name.ParseUmask(test.umask)
+			if !test.valid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, umask)
+		})
+	}
+}
+
+func TestUmask_String(t *testing.T) {
+	for _, s := range []string{"000", "022", "027", "077", "777"} {
+		umask, err := name.ParseUmask(s)
+		assert.NoError(t, err)
+		assert.Equal(t, s, umask.String())
+	}
+}
+
+func TestFileService_CreateWithoutPermissions(t *testing.T) {
+	service, err := name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+		Umask:  "027",
+	})
+	assert.NoError(t, err)
+
+	all := name.Permission{Read: true, Write: true, Delete: true, Execute: true}
+	alice := name.NewPrincipal(name.User{Name: "alice", Groups: []*name.Group{{Name: "staff"}}})
+	bob := name.NewPrincipal(name.User{Name: "bob", Groups: []*name.Group{{Name: "staff"}}, Umask: "077"})
+
+	fi, err := service.CreateDir(alice, "/alice", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", fi.Permissions.Owner)
+	assert.Equal(t, "staff", fi.Permissions.Group)
+	assert.Equal(t, all, fi.Permissions.OwnerPermission)
+	assert.Equal(t, name.Permission{Read: true, Execute: true}, fi.Permissions.GroupPermission)
+	assert.Equal(t, name.Permission{}, fi.Permissions.OtherPermission)
+
+	fi, err = service.CreateFile(alice, "/alice/a.txt", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, name.Permission{Read: true, Write: true, Delete: true}, fi.Permissions.OwnerPermission)
+	assert.Equal(t, name.Permission{Read: true}, fi.Permissions.GroupPermission)
+
+	fi, err = service.CreateFile(bob, "/bob.txt", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, name.Permission{}, fi.Permissions.GroupPermission)
+
+	// the default mask of the parent limits the group class
+	err = service.SetACL(alice, "/alice", []name.ACLEntry{
+		{Type: name.ACLTypeUser, Name: "bob", Permission: all, Default: true},
+		{Type: name.ACLTypeMask, Permission: name.Permission{Execute: true}, Default: true},
+	})
+	assert.NoError(t, err)
+
+	fi, err = service.CreateDir(alice, "/alice/docs", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, name.Permission{Execute: true}, fi.Permissions.GroupPermission)
+
+	_, err = name.NewFileService(name.FileServiceOpts{
+		Logger: createLogger(t),
+		DB:     createDB(t),
+		Umask:  "abc",
+	})
+	assert.Error(t, err)
+}
+
+func TestServer_CreateFilePermissions(t *testing.T) {
+	fileService := mocks.NewFileService(t)
+	server := name.Server{Opts: name.ServerOpts{
+		Logger:      logrus.New(),
+		FileService: fileService,
+	}}
+	ctx := name.ContextWithPrincipal(context.Background(), name.NewRootPrincipal())
+
+	fileService.EXPECT().CreateFile(mock.Anything, "/derived.txt", (*name.Permissions)(nil)).Return(name.FileInfo{}, nil)
+	_, err := server.CreateFile(ctx, &proto.CreateFileRequest{Path: "/derived.txt"})
+	assert.NoError(t, err)
+
+	fileService.EXPECT().CreateDir(mock.Anything, "/dir", &name.Permissions{
+		Owner:           "alice",
+		Group:           "staff",
+		OwnerPermission: name.Permission{Read: true, Write: true, Delete: true, Execute: true},
+		GroupPermission: name.Permission{Delete: true},
+		SetGID:          true,
+	}).Return(name.FileInfo{}, nil)
+	_, err = server.CreateDir(ctx, &proto.CreateDirRequest{Path: "/dir", Permissions: &proto.Permissions{
+		Owner:           "alice",
+		Group:           "staff",
+		OwnerPermission: &proto.Permission{Read: true, Write: true, Delete: true, Execute: true},
+		GroupPermission: &proto.Permission{Delete: true},
+		Setgid:          true,
+	}})
+	assert.NoError(t, err)
+}
+
+func TestSecurityService_SetUserUmask(t *testing.T) {
+	securityService := createSecurityService(t, createSecurityDB(t))
+	err := securityService.CreateUser(name.User{Name: "alice", Password: "secret"})
+	assert.NoError(t, err)
+
+	token, err := securityService.AuthenticateUser("alice", "secret")
+	assert.NoError(t, err)
+
+	err = securityService.SetUserUmask("alice", "77")
+	assert.NoError(t, err)
+
+	user, err := securityService.LookupUserByToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "077", user.Umask)
+	assert.Equal(t, "077", name.NewPrincipal(user).Umask())
+
+	err = securityService.SetUserUmask("alice", "999")
+	assert.Error(t, err)
+
+	err = securityService.SetUserUmask("bob", "022")
+	assert.Error(t, err)
+
+	err = securityService.SetUserUmask("alice", "")
+	assert.NoError(t, err)
+
+	user, err = securityService.GetUser("alice")
+	assert.NoError(t, err)
+	assert.Empty(t, user.Umask)
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"hash/crc32"
-	"os"
-	"path/filepath"
 	"strings"
 )
 
@@ -31,6 +29,7 @@ type BlockInfo struct {
 	Length       uint32 `gorm:"not null"`
 	Path         string `gorm:"not null"`
 	DataFilePath string `gorm:"not null"`
+	Volume       string `gorm:"not null;default:'';index"`
 	CRC          uint32 `gorm:"not null"`
 }
 
@@ -51,6 +50,10 @@ func (bi *BlockInfo) BeforeSave(_ *gorm.DB) error {
 		return fmt.Errorf("data file path is empty")
 	}
 
+	if len(bi.Volume) == 0 {
+		return fmt.Errorf("volume is empty")
+	}
+
 	if bi.Length == 0 {
 		return fmt.Errorf("length is zero")
 	}
@@ -59,11 +62,15 @@ func (bi *BlockInfo) BeforeSave(_ *gorm.DB) error {
 }
 
 type BlockServiceOpts struct {
-	Logger             *logrus.Logger
-	Host               string
-	DB                 *gorm.DB
-	Dir                string
-	NotificationClient proto.NotificationClient
+	Logger                 *logrus.Logger
+	Host                   string
+	DB                     *gorm.DB
+	Stores                 []BlockStore
+	VolumePolicy           string
+	VolumeFailureThreshold uint
+	ReservedBytes          uint64
+	ReservedPercent        float64
+	NotificationClient     proto.NotificationClient
 }
 
 func (o *BlockServiceOpts) Validate() error {
@@ -75,13 +82,30 @@ func (o *BlockServiceOpts) Validate() error {
 		return fmt.Errorf("db is required")
 	}
 
-	dirStat, err := os.Stat(o.Dir)
-	if err != nil {
-		return fmt.Errorf("could not stat dir %s: %w", o.Dir, err)
+	if len(o.Stores) == 0 {
+		return fmt.Errorf("at least one store is required")
+	}
+
+	names := map[string]bool{}
+	for _, store := range o.Stores {
+		if store == nil {
+			return fmt.Errorf("store is nil")
+		}
+
+		if names[store.Name()] {
+			return fmt.Errorf("duplicate store: %s", store.Name())
+		}
+		names[store.Name()] = true
+	}
+
+	switch o.VolumePolicy {
+	case "", VolumePolicyRoundRobin, VolumePolicyAvailableSpace:
+	default:
+		return fmt.Errorf("unknown volume policy: %s", o.VolumePolicy)
 	}
 
-	if !dirStat.IsDir() {
-		return fmt.Errorf("dir is not a directory: %s", o.Dir)
+	if o.ReservedPercent < 0 || o.ReservedPercent >= 100 {
+		return fmt.Errorf("reserved percent must be between 0 and 100: %v", o.ReservedPercent)
 	}
 
 	if o.Host == "" {
@@ -96,7 +120,8 @@ func (o *BlockServiceOpts) Validate() error {
 }
 
 type service struct {
-	opts BlockServiceOpts
+	opts    BlockServiceOpts
+	volumes *volumeSet
 }
 
 func NewBlockService(opts BlockServiceOpts) (BlockService, error) {
@@ -105,16 +130,236 @@ func NewBlockService(opts BlockServiceOpts) (BlockService, error) {
 		return nil, fmt.Errorf("options are not valid: %w", err)
 	}
 
+	if opts.VolumeFailureThreshold == 0 {
+		opts.VolumeFailureThreshold = DefaultVolumeFailureThreshold
+	}
+
+	var storeNames []string
+	for _, store := range opts.Stores {
+		storeNames = append(storeNames, store.Name())
+	}
+
 	opts.Logger.WithFields(logrus.Fields{
-		"dir":  opts.Dir,
-		"host": opts.Host,
+		"stores":           storeNames,
+		"volume-policy":    opts.VolumePolicy,
+		"reserved-bytes":   opts.ReservedBytes,
+		"reserved-percent": opts.ReservedPercent,
+		"host":             opts.Host,
 	}).Info("Constructing new service")
 
-	s := service{opts: opts}
+	s := service{
+		opts:    opts,
+		volumes: newVolumeSet(opts.Stores, opts.VolumePolicy, opts.VolumeFailureThreshold, opts.ReservedBytes, opts.ReservedPercent),
+	}
+
+	err = s.assignLegacyVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("could not assign volumes to existing blocks: %w", err)
+	}
+
+	err = s.relocateBlocks()
+	if err != nil {
+		return nil, fmt.Errorf("could not relocate blocks: %w", err)
+	}
 
 	return &s, nil
 }
 
+// assignLegacyVolumes records the volume of blocks written before the node
+// supported more than one data directory. Blocks on no configured volume are
+// reported as removed, so that the name server replicates them again.
+func (s *service) assignLegacyVolumes() error {
+	var lost []BlockInfo
+	err := s.opts.DB.Transaction(func(tx *gorm.DB) error {
+		var blockInfos []BlockInfo
+		err := tx.Where("volume = ?", "").Find(&blockInfos).Error
+		if err != nil {
+			return fmt.Errorf("failed to get blocks without volume: %w", err)
+		}
+
+		for _, blockInfo := range blockInfos {
+			vol, found := s.volumes.find(blockInfo.DataFilePath)
+			if !found {
+				lost = append(lost, blockInfo)
+				continue
+			}
+
+			err = tx.Model(&blockInfo).UpdateColumn("volume", vol).Error
+			if err != nil {
+				return fmt.Errorf("failed to update volume of block %s: %w", blockInfo.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, blockInfo := range lost {
+		logger := s.opts.Logger.WithFields(logrus.Fields{
+			"block-id":       blockInfo.ID,
+			"data-file-path": blockInfo.DataFilePath,
+		})
+		logger.Warn("Block is not on any configured volume, reporting it as lost")
+
+		// The block is only forgotten once the name server knows, otherwise
+		// the next start reports it again.
+		_, err = s.opts.NotificationClient.NotifyBlockRemoved(context.Background(), &proto.NotifyBlockRemovedRequest{
+			Host:    s.opts.Host,
+			BlockId: blockInfo.ID,
+			Path:    blockInfo.Path,
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to notify block removed")
+			continue
+		}
+
+		err = s.opts.DB.Delete(&blockInfo).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete block info %s: %w", blockInfo.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// relocateBlocks moves blocks stored at a legacy location of their store, such
+// as flat files from before the hashed layout, to their current location.
+// Blocks already at their current location are left untouched, so it is safe
+// to run on every start.
+func (s *service) relocateBlocks() error {
+	var blockInfos []BlockInfo
+	err := s.opts.DB.Where("volume <> ?", "").Find(&blockInfos).Error
+	if err != nil {
+		return fmt.Errorf("failed to get blocks: %w", err)
+	}
+
+	var allErrors []error
+
+	for _, blockInfo := range blockInfos {
+		store, found := s.volumes.get(blockInfo.Volume)
+		if !found {
+			continue
+		}
+
+		relocator, ok := store.(Relocator)
+		if !ok {
+			continue
+		}
+
+		// The new location is only committed once the block was moved. Should
+		// the commit fail, Relocate finds the block moved on the next start.
+		err := s.opts.DB.Transaction(func(tx *gorm.DB) error {
+			location, err := relocator.Relocate(blockInfo.ID, blockInfo.DataFilePath)
+			if errors.Is(err, ErrBlockNotFound) {
+				// HealthCheck drops the block and reports it as removed.
+				s.opts.Logger.WithFields(logrus.Fields{
+					"block-id":       blockInfo.ID,
+					"data-file-path": blockInfo.DataFilePath,
+				}).Warn("Block to relocate is missing")
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if location == blockInfo.DataFilePath {
+				return nil
+			}
+
+			err = tx.Model(&blockInfo).UpdateColumn("data_file_path", location).Error
+			if err != nil {
+				return fmt.Errorf("failed to update data file path of block %s: %w", blockInfo.ID, err)
+			}
+
+			s.opts.Logger.WithFields(logrus.Fields{
+				"block-id": blockInfo.ID,
+				"from":     blockInfo.DataFilePath,
+				"to":       location,
+			}).Info("Relocated block")
+
+			return nil
+		})
+		if err != nil {
+			allErrors = append(allErrors, err)
+		}
+	}
+
+	return errors.Join(allErrors...)
+}
+
+// ioError counts err against the volume unless it only says that a block is
+// missing or that the volume is full. Once the volume fails, all of its
+// blocks are reported as lost.
+func (s *service) ioError(vol string, err error) {
+	if errors.Is(err, ErrBlockNotFound) || errors.Is(err, ErrNoSpace) {
+		return
+	}
+
+	s.opts.Logger.WithError(err).WithField("volume", vol).Warn("I/O error on volume")
+
+	if s.volumes.recordError(vol) {
+		err := s.dropVolume(vol)
+		if err != nil {
+			s.opts.Logger.WithError(err).WithField("volume", vol).Error("Failed to drop blocks of failed volume")
+		}
+	}
+}
+
+// failVolume takes the volume out of service right away, e.g. when its blocks
+// can not even be listed, and reports its blocks as lost.
+func (s *service) failVolume(vol string, err error) {
+	s.opts.Logger.WithError(err).WithField("volume", vol).Error("Volume is unusable")
+
+	if s.volumes.fail(vol) {
+		err := s.dropVolume(vol)
+		if err != nil {
+			s.opts.Logger.WithError(err).WithField("volume", vol).Error("Failed to drop blocks of failed volume")
+		}
+	}
+}
+
+func (s *service) dropVolume(vol string) error {
+	s.opts.Logger.WithField("volume", vol).Error("Volume failed, reporting its blocks as lost")
+
+	var blockInfos []BlockInfo
+	err := s.opts.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("volume = ?", vol).Find(&blockInfos).Error
+		if err != nil {
+			return fmt.Errorf("failed to get blocks of volume: %w", err)
+		}
+
+		err = tx.Where("volume = ?", vol).Delete(&BlockInfo{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete blocks of volume: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to drop volume %s: %w", vol, err)
+	}
+
+	var allErrors []error
+
+	for _, blockInfo := range blockInfos {
+		_, err = s.opts.NotificationClient.NotifyBlockRemoved(context.Background(), &proto.NotifyBlockRemovedRequest{
+			Host:    s.opts.Host,
+			BlockId: blockInfo.ID,
+			Path:    blockInfo.Path,
+		})
+		allErrors = append(allErrors, err)
+	}
+
+	err = errors.Join(allErrors...)
+	if err != nil {
+		return fmt.Errorf("failed to notify blocks removed: %w", err)
+	}
+
+	return nil
+}
+
 func (s *service) GetBlockIds() ([]string, error) {
 	var blockIds []string
 	err := s.opts.DB.Transaction(func(tx *gorm.DB) error {
@@ -160,10 +405,33 @@ func (s *service) WriteBlock(id string, path string, sequence uint64, data []byt
 	if len(trimmedId) == 0 {
 		return fmt.Errorf("block id is empty")
 	}
-	dataFilePath := filepath.Join(s.opts.Dir, trimmedId)
-	err := os.WriteFile(dataFilePath, data, os.ModePerm)
+
+	// The data of an existing block must neither be overwritten nor, once
+	// the insert fails, removed.
+	var count int64
+	err := s.opts.DB.Model(&BlockInfo{}).Where("id = ?", trimmedId).Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to check for block %s: %w", trimmedId, err)
+	}
+	if count > 0 {
+		return fmt.Errorf("failed to create block info: block %s already exists", trimmedId)
+	}
+
+	store, err := s.volumes.choose(uint64(len(data)))
+	if err != nil {
+		if errors.Is(err, ErrNoSpace) {
+			_ = s.notifyStatus()
+		}
+		return fmt.Errorf("failed to choose volume: %w", err)
+	}
+
+	dataFilePath, err := store.Put(trimmedId, data)
 	if err != nil {
-		return fmt.Errorf("failed to write data file to path %s: %w", dataFilePath, err)
+		s.ioError(store.Name(), err)
+		if errors.Is(err, ErrNoSpace) {
+			_ = s.notifyStatus()
+		}
+		return fmt.Errorf("failed to store block %s: %w", trimmedId, err)
 	}
 
 	blockInfo := BlockInfo{
@@ -172,6 +440,7 @@ func (s *service) WriteBlock(id string, path string, sequence uint64, data []byt
 		Length:       uint32(len(data)),
 		Path:         path,
 		DataFilePath: dataFilePath,
+		Volume:       store.Name(),
 		CRC:          crc32.ChecksumIEEE(data),
 	}
 
@@ -184,6 +453,12 @@ func (s *service) WriteBlock(id string, path string, sequence uint64, data []byt
 		return nil
 	})
 	if err != nil {
+		// Do not leave the data behind without a record of it, e.g. when the
+		// database is on a full disk.
+		deleteErr := store.Delete(trimmedId)
+		if deleteErr != nil {
+			s.opts.Logger.WithError(deleteErr).WithField("block-id", trimmedId).Warn("Failed to remove data of unrecorded block")
+		}
 		return fmt.Errorf("failed to write block: %w", err)
 	}
 
@@ -196,6 +471,15 @@ func (s *service) WriteBlock(id string, path string, sequence uint64, data []byt
 		Length:   blockInfo.Length,
 	})
 	if err != nil {
+		// The name server did not take the block, e.g. as it exceeds the
+		// quota, so it would never be read nor deleted.
+		deleteErr := s.opts.DB.Delete(&blockInfo).Error
+		if deleteErr == nil {
+			deleteErr = store.Delete(trimmedId)
+		}
+		if deleteErr != nil {
+			s.opts.Logger.WithError(deleteErr).WithField("block-id", trimmedId).Warn("Failed to remove rejected block")
+		}
 		return fmt.Errorf("failed to notify blocks added: %w", err)
 	}
 
@@ -204,6 +488,8 @@ func (s *service) WriteBlock(id string, path string, sequence uint64, data []byt
 
 func (s *service) DeleteBlock(id string) error {
 	var path string
+	var blockPath string
+	var vol string
 	err := s.opts.DB.Transaction(func(tx *gorm.DB) error {
 		blockInfo := BlockInfo{
 			ID: id,
@@ -213,6 +499,8 @@ func (s *service) DeleteBlock(id string) error {
 			return fmt.Errorf("failed to get block info: %w", err)
 		}
 		path = blockInfo.DataFilePath
+		blockPath = blockInfo.Path
+		vol = blockInfo.Volume
 		err = tx.Delete(&blockInfo).Error
 		if err != nil {
 			return fmt.Errorf("failed to delete block info: %w", err)
@@ -227,16 +515,22 @@ func (s *service) DeleteBlock(id string) error {
 	_, err = s.opts.NotificationClient.NotifyBlockRemoved(context.Background(), &proto.NotifyBlockRemovedRequest{
 		Host:    s.opts.Host,
 		BlockId: id,
-		Path:    path,
+		Path:    blockPath,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to notify blocks removed: %w", err)
 	}
 
-	err = os.Remove(path)
+	store, found := s.volumes.get(vol)
+	if !found {
+		return nil
+	}
+
+	err = store.Delete(id)
 	if err != nil {
-		if !os.IsNotExist(err) {
-			return fmt.Errorf("failed to remove data file: %w", err)
+		if !errors.Is(err, ErrBlockNotFound) {
+			s.ioError(vol, err)
+			return fmt.Errorf("failed to remove data file %s: %w", path, err)
 		}
 	}
 
@@ -258,8 +552,14 @@ func (s *service) ReadBlock(id string) ([]byte, BlockInfo, error) {
 		return nil, blockInfo, fmt.Errorf("failed to get block info: %w", err)
 	}
 
-	data, err = os.ReadFile(blockInfo.DataFilePath)
+	store, found := s.volumes.get(blockInfo.Volume)
+	if !found {
+		return nil, blockInfo, fmt.Errorf("volume %s of block %s is not available", blockInfo.Volume, id)
+	}
+
+	data, err = store.Get(id)
 	if err != nil {
+		s.ioError(blockInfo.Volume, err)
 		return nil, blockInfo, fmt.Errorf("failed to read data file %s: %w", blockInfo.DataFilePath, err)
 	}
 
@@ -274,6 +574,27 @@ func (s *service) ReadBlock(id string) ([]byte, BlockInfo, error) {
 	return data, blockInfo, nil
 }
 
+// notifyStatus tells the name server whether the node still has room for new
+// blocks, so that it is left out of block placement while it is full.
+func (s *service) notifyStatus() error {
+	full := s.volumes.full()
+	_, err := s.opts.NotificationClient.NotifyNodeStatus(context.Background(), &proto.NotifyNodeStatusRequest{
+		Host:           s.opts.Host,
+		Full:           full,
+		AvailableBytes: s.volumes.available(),
+	})
+	if err != nil {
+		s.opts.Logger.WithError(err).Warn("Failed to notify node status")
+		return fmt.Errorf("failed to notify node status: %w", err)
+	}
+
+	if full {
+		s.opts.Logger.Warn("Node is full, no volume has space left above the reserve")
+	}
+
+	return nil
+}
+
 func (s *service) Report() error {
 	blockInfos, err := s.GetBlocks()
 	if err != nil {
@@ -281,6 +602,8 @@ func (s *service) Report() error {
 	}
 	var allErrors []error
 
+	allErrors = append(allErrors, s.notifyStatus())
+
 	for _, blockInfo := range blockInfos {
 		_, err = s.opts.NotificationClient.NotifyBlockPresent(context.Background(), &proto.NotifyBlockPresentRequest{
 			Host:     s.opts.Host,
@@ -302,6 +625,13 @@ func (s *service) Report() error {
 }
 
 func (s *service) HealthCheck() error {
+	for _, store := range s.volumes.healthy() {
+		_, err := store.List()
+		if err != nil {
+			s.ioError(store.Name(), err)
+		}
+	}
+
 	blockInfos, err := s.GetBlocks()
 	if err != nil {
 		return fmt.Errorf("failed to get blocks: %w", err)
@@ -310,9 +640,13 @@ func (s *service) HealthCheck() error {
 	var allErrors []error
 
 	for _, blockInfo := range blockInfos {
-		path := blockInfo.DataFilePath
+		store, found := s.volumes.get(blockInfo.Volume)
+		if !found {
+			continue
+		}
 
-		if _, err = os.Stat(path); os.IsNotExist(err) {
+		_, err = store.Stat(blockInfo.ID)
+		if errors.Is(err, ErrBlockNotFound) {
 			err = s.DeleteBlock(blockInfo.ID)
 			if err != nil {
 				allErrors = append(allErrors, fmt.Errorf("failed to delete block info: %w", err))
@@ -329,39 +663,40 @@ func (s *service) HealthCheck() error {
 }
 
 func (s *service) ValidateCRC() error {
+	type key struct {
+		volume string
+		id     string
+	}
 	type record struct {
 		crc    uint32
 		length uint32
 	}
-	pathRecords := map[string]record{}
-
-	files, err := os.ReadDir(s.opts.Dir)
-	if err != nil {
-		return fmt.Errorf("cannot read dir %s : %w", s.opts.Dir, err)
-	}
-
-	for _, f := range files {
-		if f.IsDir() {
-			continue
-		}
+	blockRecords := map[key]record{}
+	validated := map[string]bool{}
 
-		path := f.Name()
-		_, err := f.Info()
+	// A volume that can not be listed fails on its own, the others are
+	// still validated.
+	for _, store := range s.volumes.healthy() {
+		ids, err := store.List()
 		if err != nil {
+			s.failVolume(store.Name(), fmt.Errorf("cannot list blocks of volume %s: %w", store.Name(), err))
 			continue
 		}
+		validated[store.Name()] = true
 
-		data, err := os.ReadFile(path)
-		if err != nil {
-			err := os.Remove(path)
+		for _, id := range ids {
+			data, err := store.Get(id)
 			if err != nil {
-				continue
+				err := store.Delete(id)
+				if err != nil {
+					continue
+				}
 			}
-		}
 
-		pathRecords[path] = record{
-			crc:    crc32.ChecksumIEEE(data),
-			length: uint32(len(data)),
+			blockRecords[key{volume: store.Name(), id: id}] = record{
+				crc:    crc32.ChecksumIEEE(data),
+				length: uint32(len(data)),
+			}
 		}
 	}
 
@@ -373,13 +708,17 @@ func (s *service) ValidateCRC() error {
 	var allErrors []error
 
 	for _, blockInfo := range blockInfos {
-		path := blockInfo.DataFilePath
+		if !validated[blockInfo.Volume] {
+			continue
+		}
+
+		k := key{volume: blockInfo.Volume, id: blockInfo.ID}
 		blockCRC := blockInfo.CRC
 		blockLength := blockInfo.Length
 
 		willDelete := false
 
-		record, found := pathRecords[path]
+		record, found := blockRecords[k]
 		if found {
 			if record.crc != blockCRC || record.length != blockLength {
 				willDelete = true
@@ -387,7 +726,7 @@ func (s *service) ValidateCRC() error {
 		} else {
 			willDelete = true
 		}
-		delete(pathRecords, path)
+		delete(blockRecords, k)
 
 		if willDelete {
 			err = s.DeleteBlock(blockInfo.ID)
@@ -395,8 +734,13 @@ func (s *service) ValidateCRC() error {
 		}
 	}
 
-	for path := range pathRecords {
-		err = os.Remove(path)
+	for k := range blockRecords {
+		store, found := s.volumes.get(k.volume)
+		if !found {
+			continue
+		}
+
+		err = store.Delete(k.id)
 		allErrors = append(allErrors, err)
 	}
 
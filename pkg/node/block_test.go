@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"github.com/cirglo.com/dfs/pkg/mocks"
 	"github.com/cirglo.com/dfs/pkg/node"
+	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"hash/crc32"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -47,6 +50,13 @@ func createDir(t *testing.T) string {
 	return absPath
 }
 
+func createStore(t *testing.T, dir string) node.BlockStore {
+	store, err := node.NewLocalBlockStore(dir)
+	assert.NoError(t, err)
+
+	return store
+}
+
 func TestBlockService_Write_Read_Delete_Block(t *testing.T) {
 	log := createLogger(t)
 	db := createDB(t)
@@ -56,7 +66,7 @@ func TestBlockService_Write_Read_Delete_Block(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -121,7 +131,7 @@ func TestBlockService_GetBlockIds(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -151,7 +161,7 @@ func TestBlockService_HealthCheck(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -195,7 +205,7 @@ func TestBlockService_ValidateCRC(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -239,7 +249,7 @@ func TestBlockService_WriteBlock_EmptyID(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -261,7 +271,7 @@ func TestBlockService_WriteBlock_DuplicateID(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -276,9 +286,41 @@ func TestBlockService_WriteBlock_DuplicateID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to create block info")
 
+	d, _, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test data"), d)
+
 	notificationClient.AssertExpectations(t)
 }
 
+func TestBlockService_WriteBlock_InsertFails(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	store := createStore(t, dir)
+	notificationClient := mocks.NewNotificationClient(t)
+	service, err := node.NewBlockService(node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{store},
+		NotificationClient: notificationClient,
+	})
+	assert.NoError(t, err)
+
+	err = db.Callback().Create().Before("gorm:create").Register("fail", func(tx *gorm.DB) {
+		_ = tx.AddError(fmt.Errorf("database or disk is full"))
+	})
+	assert.NoError(t, err)
+
+	err = service.WriteBlock(uuid.New().String(), "/test.txt", 0, []byte("test data"))
+	assert.Error(t, err)
+
+	ids, err := store.List()
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
 func TestBlockService_WriteBlock_InvalidPath(t *testing.T) {
 	log := createLogger(t)
 	db := createDB(t)
@@ -288,7 +330,7 @@ func TestBlockService_WriteBlock_InvalidPath(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -308,7 +350,7 @@ func TestBlockService_NotificationError(t *testing.T) {
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
 		NotificationClient: notificationClient,
 	}
 	service, err := node.NewBlockService(opts)
@@ -323,23 +365,646 @@ func TestBlockService_NotificationError(t *testing.T) {
 	err = service.WriteBlock(id, "/test.txt", 1, []byte("test data"))
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to notify blocks added")
+
+	// the rejected block is removed, so it can be written again
+	_, _, err = service.ReadBlock(id)
+	assert.Error(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	err = service.WriteBlock(id, "/test.txt", 1, []byte("test data"))
+	assert.NoError(t, err)
 }
 
 func TestBlockService_MissingDirectory(t *testing.T) {
+	dir := createDir(t)
+	os.RemoveAll(dir) // Remove the directory to simulate missing directory
+
+	_, err := node.NewLocalBlockStore(dir)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not stat dir")
+}
+
+func TestBlockService_NoStores(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		NotificationClient: notificationClient,
+	}
+	_, err := node.NewBlockService(opts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one store is required")
+}
+
+func TestBlockService_MemoryStore(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{node.NewMemoryBlockStore("memory")},
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	id := uuid.New().String()
+	err = service.WriteBlock(id, "/test.txt", 0, []byte("test data"))
+	assert.NoError(t, err)
+
+	d, bi, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test data"), d)
+	assert.Equal(t, "memory", bi.Volume)
+
+	err = service.ValidateCRC()
+	assert.NoError(t, err)
+
+	err = service.HealthCheck()
+	assert.NoError(t, err)
+
+	blocks, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_MultipleVolumes_RoundRobin(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir1 := createDir(t)
+	dir2 := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir1), createStore(t, dir2)},
+		VolumePolicy:       node.VolumePolicyRoundRobin,
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	id1 := uuid.New().String()
+	err = service.WriteBlock(id1, "/test.txt", 0, []byte("test data 1"))
+	assert.NoError(t, err)
+	id2 := uuid.New().String()
+	err = service.WriteBlock(id2, "/test.txt", 1, []byte("test data 2"))
+	assert.NoError(t, err)
+
+	_, bi1, err := service.ReadBlock(id1)
+	assert.NoError(t, err)
+	assert.Equal(t, dir1, bi1.Volume)
+	assert.True(t, strings.HasPrefix(bi1.DataFilePath, dir1))
+
+	_, bi2, err := service.ReadBlock(id2)
+	assert.NoError(t, err)
+	assert.Equal(t, dir2, bi2.Volume)
+	assert.True(t, strings.HasPrefix(bi2.DataFilePath, dir2))
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_MultipleVolumes_AvailableSpace(t *testing.T) {
 	log := createLogger(t)
 	db := createDB(t)
 	dir := createDir(t)
-	os.RemoveAll(dir) // Remove the directory to simulate missing directory
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir)},
+		VolumePolicy:       node.VolumePolicyAvailableSpace,
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
 
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	id := uuid.New().String()
+	err = service.WriteBlock(id, "/test.txt", 0, []byte("test data"))
+	assert.NoError(t, err)
+
+	_, bi, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, dir, bi.Volume)
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_InvalidVolumePolicy(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
 	notificationClient := mocks.NewNotificationClient(t)
 	opts := node.BlockServiceOpts{
 		Logger:             log,
 		Host:               "whoof:2345",
 		DB:                 db,
-		Dir:                dir,
+		Stores:             []node.BlockStore{createStore(t, dir)},
+		VolumePolicy:       "random",
 		NotificationClient: notificationClient,
 	}
 	_, err := node.NewBlockService(opts)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "could not stat dir")
+	assert.Contains(t, err.Error(), "unknown volume policy")
+}
+
+func TestBlockService_VolumeFailure(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir1 := createDir(t)
+	dir2 := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:                 log,
+		Host:                   "whoof:2345",
+		DB:                     db,
+		Stores:                 []node.BlockStore{createStore(t, dir1), createStore(t, dir2)},
+		VolumeFailureThreshold: 1,
+		NotificationClient:     notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	id1 := uuid.New().String()
+	err = service.WriteBlock(id1, "/test.txt", 0, []byte("test data 1"))
+	assert.NoError(t, err)
+	id2 := uuid.New().String()
+	err = service.WriteBlock(id2, "/test.txt", 1, []byte("test data 2"))
+	assert.NoError(t, err)
+
+	// Replace the first volume with a plain file so that reads fail with an I/O error
+	err = os.RemoveAll(dir1)
+	assert.NoError(t, err)
+	err = os.WriteFile(dir1, []byte("not a directory"), os.ModePerm)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().
+		NotifyBlockRemoved(mock.Anything, mock.MatchedBy(func(r *proto.NotifyBlockRemovedRequest) bool {
+			return r.GetBlockId() == id1
+		})).
+		Return(nil, nil).
+		Once()
+
+	_, _, err = service.ReadBlock(id1)
+	assert.Error(t, err)
+
+	blocks, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, id2, blocks[0].ID)
+
+	d, _, err := service.ReadBlock(id2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test data 2"), d)
+
+	// New blocks only go to the healthy volume
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	for i := 0; i < 2; i++ {
+		id := uuid.New().String()
+		err = service.WriteBlock(id, "/test.txt", uint64(2+i), []byte("more data"))
+		assert.NoError(t, err)
+
+		_, bi, err := service.ReadBlock(id)
+		assert.NoError(t, err)
+		assert.Equal(t, dir2, bi.Volume)
+	}
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_ValidateCRC_VolumeFailure(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir1 := createDir(t)
+	dir2 := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	service, err := node.NewBlockService(node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir1), createStore(t, dir2)},
+		NotificationClient: notificationClient,
+	})
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	id1 := uuid.New().String()
+	err = service.WriteBlock(id1, "/test.txt", 0, []byte("test data 1"))
+	assert.NoError(t, err)
+	id2 := uuid.New().String()
+	err = service.WriteBlock(id2, "/test.txt", 1, []byte("test data 2"))
+	assert.NoError(t, err)
+
+	// The first volume can no longer be listed
+	err = os.RemoveAll(dir1)
+	assert.NoError(t, err)
+	err = os.WriteFile(dir1, []byte("not a directory"), os.ModePerm)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().
+		NotifyBlockRemoved(mock.Anything, mock.MatchedBy(func(r *proto.NotifyBlockRemovedRequest) bool {
+			return r.GetBlockId() == id1
+		})).
+		Return(nil, nil).
+		Once()
+
+	err = service.ValidateCRC()
+	assert.NoError(t, err)
+
+	blocks, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, id2, blocks[0].ID)
+
+	d, _, err := service.ReadBlock(id2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test data 2"), d)
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_HashedLayout(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir)},
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	id := uuid.New().String()
+	err = service.WriteBlock(id, "/test.txt", 0, []byte("test data"))
+	assert.NoError(t, err)
+
+	blocks, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+
+	rel, err := filepath.Rel(dir, blocks[0].DataFilePath)
+	assert.NoError(t, err)
+	parts := strings.Split(rel, string(filepath.Separator))
+	assert.Len(t, parts, 3)
+	assert.Len(t, parts[0], 2)
+	assert.Len(t, parts[1], 2)
+	assert.Equal(t, id, parts[2])
+
+	// Files outside of the layout are not touched by the CRC validation
+	otherFile := filepath.Join(dir, "nodeserver.db")
+	err = os.WriteFile(otherFile, []byte("not a block"), os.ModePerm)
+	assert.NoError(t, err)
+
+	err = service.ValidateCRC()
+	assert.NoError(t, err)
+
+	blocks, err = service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+
+	_, err = os.Stat(otherFile)
+	assert.NoError(t, err)
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_MigrateFlatLayout(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+
+	id := uuid.New().String()
+	data := []byte("test data")
+	flatPath := filepath.Join(dir, id)
+	err := os.WriteFile(flatPath, data, os.ModePerm)
+	assert.NoError(t, err)
+
+	// A block written before volumes and the hashed layout existed
+	err = db.Session(&gorm.Session{SkipHooks: true}).Create(&node.BlockInfo{
+		ID:           id,
+		Sequence:     0,
+		Length:       uint32(len(data)),
+		Path:         "/test.txt",
+		DataFilePath: flatPath,
+		CRC:          crc32.ChecksumIEEE(data),
+	}).Error
+	assert.NoError(t, err)
+
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir)},
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	d, bi, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, data, d)
+	assert.Equal(t, dir, bi.Volume)
+	assert.NotEqual(t, flatPath, bi.DataFilePath)
+
+	_, err = os.Stat(flatPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// Running the migration again is a no-op
+	_, err = node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	d, _, err = service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, data, d)
+}
+
+func TestBlockService_MigrateFlatLayout_UnknownVolume(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	notificationClient := mocks.NewNotificationClient(t)
+
+	// A block in a data directory that is no longer configured
+	id := uuid.New().String()
+	err := db.Session(&gorm.Session{SkipHooks: true}).Create(&node.BlockInfo{
+		ID:           id,
+		Sequence:     0,
+		Length:       9,
+		Path:         "/test.txt",
+		DataFilePath: filepath.Join(createDir(t), id),
+	}).Error
+	assert.NoError(t, err)
+
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, dir)},
+		NotificationClient: notificationClient,
+	}
+
+	// the block is kept until the name server knows it is gone
+	notificationClient.EXPECT().
+		NotifyBlockRemoved(mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("notification error")).
+		Once()
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+	ids, err := service.GetBlockIds()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{id}, ids)
+
+	notificationClient.EXPECT().
+		NotifyBlockRemoved(mock.Anything, mock.MatchedBy(func(request *proto.NotifyBlockRemovedRequest) bool {
+			return request.GetBlockId() == id && request.GetPath() == "/test.txt"
+		})).
+		Return(nil, nil).
+		Once()
+	service, err = node.NewBlockService(opts)
+	assert.NoError(t, err)
+	ids, err = service.GetBlockIds()
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestBlockService_MigrateFlatLayout_AlreadyMoved(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	store := createStore(t, dir)
+	notificationClient := mocks.NewNotificationClient(t)
+
+	// A block moved by an earlier start that failed to record its new path
+	id := uuid.New().String()
+	data := []byte("test data")
+	path, err := store.Put(id, data)
+	assert.NoError(t, err)
+
+	err = db.Create(&node.BlockInfo{
+		ID:           id,
+		Sequence:     0,
+		Length:       uint32(len(data)),
+		Path:         "/test.txt",
+		DataFilePath: filepath.Join(dir, id),
+		Volume:       dir,
+		CRC:          crc32.ChecksumIEEE(data),
+	}).Error
+	assert.NoError(t, err)
+
+	service, err := node.NewBlockService(node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{store},
+		NotificationClient: notificationClient,
+	})
+	assert.NoError(t, err)
+
+	d, bi, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, data, d)
+	assert.Equal(t, path, bi.DataFilePath)
+}
+
+func TestBlockService_MigrateFlatLayout_Missing(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	dir := createDir(t)
+	store := createStore(t, dir)
+	notificationClient := mocks.NewNotificationClient(t)
+
+	// A block whose data file was lost before the hashed layout
+	id := uuid.New().String()
+	err := db.Create(&node.BlockInfo{
+		ID:           id,
+		Sequence:     0,
+		Length:       9,
+		Path:         "/test.txt",
+		DataFilePath: filepath.Join(dir, id),
+		Volume:       dir,
+	}).Error
+	assert.NoError(t, err)
+
+	service, err := node.NewBlockService(node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{store},
+		NotificationClient: notificationClient,
+	})
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().
+		NotifyBlockRemoved(mock.Anything, mock.MatchedBy(func(request *proto.NotifyBlockRemovedRequest) bool {
+			return request.GetBlockId() == id
+		})).
+		Return(nil, nil).
+		Once()
+
+	err = service.HealthCheck()
+	assert.NoError(t, err)
+
+	blockInfos, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Empty(t, blockInfos)
+}
+
+func TestBlockService_MigrateFlatLayout_RelativeDir(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	t.Chdir(createDir(t))
+	notificationClient := mocks.NewNotificationClient(t)
+
+	// Blocks written with the default data dir "./" have relative paths
+	id := uuid.New().String()
+	data := []byte("test data")
+	flatPath := filepath.Join("./", id)
+	err := os.WriteFile(flatPath, data, os.ModePerm)
+	assert.NoError(t, err)
+
+	err = db.Session(&gorm.Session{SkipHooks: true}).Create(&node.BlockInfo{
+		ID:           id,
+		Sequence:     0,
+		Length:       uint32(len(data)),
+		Path:         "/test.txt",
+		DataFilePath: flatPath,
+		CRC:          crc32.ChecksumIEEE(data),
+	}).Error
+	assert.NoError(t, err)
+
+	service, err := node.NewBlockService(node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{createStore(t, "./")},
+		NotificationClient: notificationClient,
+	})
+	assert.NoError(t, err)
+
+	d, bi, err := service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, data, d)
+	assert.Equal(t, ".", bi.Volume)
+
+	_, err = os.Stat(flatPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// The block survives the validation instead of being deleted as unknown
+	assert.NoError(t, service.ValidateCRC())
+	d, _, err = service.ReadBlock(id)
+	assert.NoError(t, err)
+	assert.Equal(t, data, d)
+}
+
+// sizedStore is a memory store that reports a fixed capacity.
+type sizedStore struct {
+	node.BlockStore
+	capacity node.Capacity
+}
+
+func (s *sizedStore) Capacity() (node.Capacity, error) {
+	return s.capacity, nil
+}
+
+func TestBlockService_ReservedSpace(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	full := &sizedStore{
+		BlockStore: node.NewMemoryBlockStore("full"),
+		capacity:   node.Capacity{Total: 1000, Available: 150},
+	}
+	roomy := &sizedStore{
+		BlockStore: node.NewMemoryBlockStore("roomy"),
+		capacity:   node.Capacity{Total: 1000, Available: 900},
+	}
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{full, roomy},
+		ReservedBytes:      100,
+		ReservedPercent:    10,
+		NotificationClient: notificationClient,
+	}
+	service, err := node.NewBlockService(opts)
+	assert.NoError(t, err)
+
+	notificationClient.EXPECT().NotifyBlockAdded(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	for i := 0; i < 2; i++ {
+		id := uuid.New().String()
+		err = service.WriteBlock(id, "/test.txt", uint64(i), make([]byte, 100))
+		assert.NoError(t, err)
+
+		_, bi, err := service.ReadBlock(id)
+		assert.NoError(t, err)
+		assert.Equal(t, "roomy", bi.Volume)
+	}
+
+	roomy.capacity.Available = 120
+	notificationClient.EXPECT().
+		NotifyNodeStatus(mock.Anything, &proto.NotifyNodeStatusRequest{
+			Host:           "whoof:2345",
+			Full:           false,
+			AvailableBytes: 70,
+		}).
+		Return(nil, nil).
+		Once()
+	err = service.WriteBlock(uuid.New().String(), "/test.txt", 2, make([]byte, 100))
+	assert.ErrorIs(t, err, node.ErrNoSpace)
+
+	full.capacity.Available = 90
+	roomy.capacity.Available = 90
+	notificationClient.EXPECT().
+		NotifyNodeStatus(mock.Anything, &proto.NotifyNodeStatusRequest{
+			Host:           "whoof:2345",
+			Full:           true,
+			AvailableBytes: 0,
+		}).
+		Return(nil, nil).
+		Once()
+	err = service.WriteBlock(uuid.New().String(), "/test.txt", 3, []byte("x"))
+	assert.ErrorIs(t, err, node.ErrNoSpace)
+
+	blocks, err := service.GetBlocks()
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 2)
+
+	notificationClient.AssertExpectations(t)
+}
+
+func TestBlockService_InvalidReservedPercent(t *testing.T) {
+	log := createLogger(t)
+	db := createDB(t)
+	notificationClient := mocks.NewNotificationClient(t)
+	opts := node.BlockServiceOpts{
+		Logger:             log,
+		Host:               "whoof:2345",
+		DB:                 db,
+		Stores:             []node.BlockStore{node.NewMemoryBlockStore("memory")},
+		ReservedPercent:    100,
+		NotificationClient: notificationClient,
+	}
+	_, err := node.NewBlockService(opts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "reserved percent must be between 0 and 100")
 }
@@ -0,0 +1,50 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/cirglo.com/dfs/pkg/proto"
+)
+
+// FetchBlockKeys replaces the keys of the keyring with the current block keys
+// of the name server. The node key opens the secrets the name server sealed
+// with it, it is nil for nodes authenticated by their TLS client certificate.
+func FetchBlockKeys(ctx context.Context, client proto.NotificationClient, host string, nodeKey []byte, keyring *blocktoken.Keyring) error {
+	resp, err := client.GetBlockKeys(ctx, &proto.GetBlockKeysRequest{Host: host})
+	if err != nil {
+		return fmt.Errorf("could not get block keys: %w", err)
+	}
+
+	var keys []blocktoken.Key
+
+	if resp.GetSealed() && nodeKey == nil {
+		return fmt.Errorf("name server sealed the block keys, but this node has no key")
+	}
+
+	for _, key := range resp.GetKeys() {
+		secret := key.GetSecret()
+		if resp.GetSealed() {
+			secret, err = proto.OpenBlockKey(nodeKey, key.GetId(), secret)
+			if err != nil {
+				return err
+			}
+		}
+
+		keys = append(keys, blocktoken.Key{
+			ID:        key.GetId(),
+			Secret:    secret,
+			ExpiresAt: time.Unix(key.GetExpiresAt(), 0),
+		})
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("name server returned no block keys")
+	}
+
+	keyring.Set(keys)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
+	"github.com/cirglo.com/dfs/pkg/mocks"
+	"github.com/cirglo.com/dfs/pkg/node"
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchBlockKeys_Sealed(t *testing.T) {
+	nodeKey := proto.DeriveNodeKey([]byte("cluster-secret"), "node1:55055")
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	sealed, err := proto.SealBlockKey(nodeKey, 1, secret)
+	assert.NoError(t, err)
+
+	client := mocks.NewNotificationClient(t)
+	client.On("GetBlockKeys", mock.Anything, &proto.GetBlockKeysRequest{Host: "node1:55055"}).Return(&proto.GetBlockKeysResponse{
+		Keys:   []*proto.BlockKey{{Id: 1, Secret: sealed, ExpiresAt: time.Now().Add(time.Hour).Unix()}},
+		Sealed: true,
+	}, nil)
+
+	keyring := blocktoken.NewKeyring()
+	assert.Error(t, node.FetchBlockKeys(context.Background(), client, "node1:55055", nil, keyring))
+	assert.Empty(t, keyring.Keys())
+
+	assert.NoError(t, node.FetchBlockKeys(context.Background(), client, "node1:55055", nodeKey, keyring))
+	keys := keyring.Keys()
+	assert.Len(t, keys, 1)
+	assert.Equal(t, secret, keys[0].Secret)
+}
@@ -1,3 +1,4 @@
 package node
 
 //go:generate mockery --name=BlockService --with-expecter --dir=./ --output=../mocks --outpkg=mocks
+//go:generate mockery --name=BlockStore --with-expecter --dir=./ --output=../mocks --outpkg=mocks
@@ -0,0 +1,74 @@
+package node
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// blockFilePath spreads block files over a two level directory tree derived
+// from the hash of the block id, e.g. <volume>/3f/a2/<id>.
+func blockFilePath(vol string, id string) string {
+	sum := sha256.Sum256([]byte(id))
+	hash := hex.EncodeToString(sum[:2])
+
+	return filepath.Join(vol, hash[0:2], hash[2:4], id)
+}
+
+// isBlockDir reports whether name is a directory level of the hashed layout.
+func isBlockDir(name string) bool {
+	if len(name) != 2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(name)
+
+	return err == nil
+}
+
+// listBlockFiles returns the paths of all data files in the hashed layout of a
+// volume. Files outside of the layout are ignored.
+func listBlockFiles(vol string) ([]string, error) {
+	var paths []string
+
+	level1, err := os.ReadDir(vol)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read dir %s : %w", vol, err)
+	}
+
+	for _, d1 := range level1 {
+		if !d1.IsDir() || !isBlockDir(d1.Name()) {
+			continue
+		}
+
+		dir1 := filepath.Join(vol, d1.Name())
+		level2, err := os.ReadDir(dir1)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read dir %s : %w", dir1, err)
+		}
+
+		for _, d2 := range level2 {
+			if !d2.IsDir() || !isBlockDir(d2.Name()) {
+				continue
+			}
+
+			dir2 := filepath.Join(dir1, d2.Name())
+			files, err := os.ReadDir(dir2)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read dir %s : %w", dir2, err)
+			}
+
+			for _, f := range files {
+				if f.IsDir() {
+					continue
+				}
+
+				paths = append(paths, filepath.Join(dir2, f.Name()))
+			}
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,222 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const tmpSuffix = ".tmp"
+
+type localBlockStore struct {
+	dir string
+}
+
+var _ BlockStore = &localBlockStore{}
+var _ CapacityReporter = &localBlockStore{}
+var _ Relocator = &localBlockStore{}
+
+// NewLocalBlockStore stores blocks as files in the hashed layout below dir.
+func NewLocalBlockStore(dir string) (BlockStore, error) {
+	dirStat, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not stat dir %s: %w", dir, err)
+	}
+
+	if !dirStat.IsDir() {
+		return nil, fmt.Errorf("dir is not a directory: %s", dir)
+	}
+
+	s := &localBlockStore{dir: filepath.Clean(dir)}
+
+	err = s.removeTempFiles()
+	if err != nil {
+		return nil, fmt.Errorf("could not clean up dir %s: %w", dir, err)
+	}
+
+	return s, nil
+}
+
+// removeTempFiles deletes data files left half written, e.g. by a crash or a
+// full disk.
+func (s *localBlockStore) removeTempFiles() error {
+	paths, err := listBlockFiles(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if !strings.HasSuffix(path, tmpSuffix) {
+			continue
+		}
+
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *localBlockStore) Name() string {
+	return s.dir
+}
+
+func (s *localBlockStore) wrap(id string, err error) error {
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%w: %s: %w", ErrBlockNotFound, id, err)
+	}
+
+	return err
+}
+
+func (s *localBlockStore) Put(id string, data []byte) (string, error) {
+	path := blockFilePath(s.dir, id)
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("failed to create dir for data file %s: %w", path, err)
+	}
+
+	// Write to a temporary file first so that a block is either complete or
+	// absent, even when the disk fills up in the middle of a write.
+	tmpPath := path + tmpSuffix
+	err = os.WriteFile(tmpPath, data, os.ModePerm)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		if errors.Is(err, syscall.ENOSPC) {
+			return "", fmt.Errorf("%w: failed to write data file to path %s: %w", ErrNoSpace, path, err)
+		}
+		return "", fmt.Errorf("failed to write data file to path %s: %w", path, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return "", fmt.Errorf("failed to move data file to path %s: %w", path, err)
+	}
+
+	return path, nil
+}
+
+func (s *localBlockStore) Get(id string) ([]byte, error) {
+	path := blockFilePath(s.dir, id)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data file %s: %w", path, s.wrap(id, err))
+	}
+
+	return data, nil
+}
+
+func (s *localBlockStore) GetRange(id string, offset uint64, length uint64) ([]byte, error) {
+	path := blockFilePath(s.dir, id)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data file %s: %w", path, s.wrap(id, err))
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat data file %s: %w", path, err)
+	}
+
+	err = checkRange(id, uint64(info.Size()), offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, length)
+	_, err = f.ReadAt(data, int64(offset))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read data file %s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+func (s *localBlockStore) Delete(id string) error {
+	path := blockFilePath(s.dir, id)
+	err := os.Remove(path)
+	if err != nil {
+		return fmt.Errorf("failed to remove data file %s: %w", path, s.wrap(id, err))
+	}
+
+	return nil
+}
+
+func (s *localBlockStore) List() ([]string, error) {
+	paths, err := listBlockFiles(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, tmpSuffix) {
+			continue
+		}
+
+		ids = append(ids, filepath.Base(path))
+	}
+
+	return ids, nil
+}
+
+func (s *localBlockStore) Stat(id string) (BlockStat, error) {
+	path := blockFilePath(s.dir, id)
+	info, err := os.Stat(path)
+	if err != nil {
+		return BlockStat{}, fmt.Errorf("failed to stat data file %s: %w", path, s.wrap(id, err))
+	}
+
+	return BlockStat{
+		ID:       id,
+		Location: path,
+		Length:   uint64(info.Size()),
+	}, nil
+}
+
+func (s *localBlockStore) Capacity() (Capacity, error) {
+	return diskCapacity(s.dir)
+}
+
+// Relocate moves a data file stored directly in the volume, as done before
+// the hashed layout, to its place in the hashed layout. A block that was
+// already moved, but whose new location was not recorded, is left in place.
+// ErrBlockNotFound is returned when the block is at neither place.
+func (s *localBlockStore) Relocate(id string, location string) (string, error) {
+	path := blockFilePath(s.dir, id)
+	if location == path {
+		return path, nil
+	}
+
+	_, err := os.Stat(location)
+	if os.IsNotExist(err) {
+		_, err = os.Stat(path)
+		if err == nil {
+			return path, nil
+		}
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("failed to create dir for block %s: %w", id, err)
+	}
+
+	err = os.Rename(location, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to move block %s: %w", id, err)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,114 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+type memoryBlockStore struct {
+	name   string
+	lock   sync.RWMutex
+	blocks map[string][]byte
+}
+
+var _ BlockStore = &memoryBlockStore{}
+
+// NewMemoryBlockStore keeps blocks in memory. Its content is lost when the
+// process exits, so it is meant for tests and hot data.
+func NewMemoryBlockStore(name string) BlockStore {
+	return &memoryBlockStore{
+		name:   name,
+		blocks: map[string][]byte{},
+	}
+}
+
+func (s *memoryBlockStore) Name() string {
+	return s.name
+}
+
+func (s *memoryBlockStore) location(id string) string {
+	return fmt.Sprintf("%s/%s", s.name, id)
+}
+
+func (s *memoryBlockStore) Put(id string, data []byte) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.blocks[id] = append([]byte(nil), data...)
+
+	return s.location(id), nil
+}
+
+func (s *memoryBlockStore) Get(id string) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	data, found := s.blocks[id]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+	}
+
+	return append([]byte(nil), data...), nil
+}
+
+func (s *memoryBlockStore) GetRange(id string, offset uint64, length uint64) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	data, found := s.blocks[id]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+	}
+
+	err := checkRange(id, uint64(len(data)), offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(nil), data[offset:offset+length]...), nil
+}
+
+func (s *memoryBlockStore) Delete(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, found := s.blocks[id]; !found {
+		return fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+	}
+
+	delete(s.blocks, id)
+
+	return nil
+}
+
+func (s *memoryBlockStore) List() ([]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var ids []string
+
+	for id := range s.blocks {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (s *memoryBlockStore) Stat(id string) (BlockStat, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	data, found := s.blocks[id]
+	if !found {
+		return BlockStat{}, fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+	}
+
+	return BlockStat{
+		ID:       id,
+		Location: s.location(id),
+		Length:   uint64(len(data)),
+	}, nil
+}
@@ -0,0 +1,444 @@
+package node
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type S3BlockStoreOpts struct {
+	Endpoint   string
+	Region     string
+	Bucket     string
+	Prefix     string
+	AccessKey  string
+	SecretKey  string
+	HTTPClient *http.Client
+}
+
+func (o *S3BlockStoreOpts) Validate() error {
+	if o.Endpoint == "" {
+		return fmt.Errorf("endpoint is required")
+	}
+
+	endpoint, err := url.Parse(o.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %s: %w", o.Endpoint, err)
+	}
+
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return fmt.Errorf("endpoint must be an http or https url: %s", o.Endpoint)
+	}
+
+	if o.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	if o.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+
+	// Objects below the prefix without a block of the node are deleted, so
+	// nodes sharing a bucket must not share a prefix.
+	if strings.Trim(o.Prefix, "/") == "" {
+		return fmt.Errorf("prefix is required")
+	}
+
+	if o.AccessKey == "" {
+		return fmt.Errorf("access key is required")
+	}
+
+	if o.SecretKey == "" {
+		return fmt.Errorf("secret key is required")
+	}
+
+	return nil
+}
+
+// s3BlockStore keeps blocks as objects in a bucket of an S3 compatible object
+// store. Requests use path style addressing and are signed with AWS
+// signature version 4.
+type s3BlockStore struct {
+	opts     S3BlockStoreOpts
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ BlockStore = &s3BlockStore{}
+
+func NewS3BlockStore(opts S3BlockStoreOpts) (BlockStore, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are not valid: %w", err)
+	}
+
+	endpoint, _ := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 1 * time.Minute}
+	}
+
+	return &s3BlockStore{
+		opts:     opts,
+		endpoint: endpoint,
+		client:   client,
+	}, nil
+}
+
+func (s *s3BlockStore) Name() string {
+	return fmt.Sprintf("s3://%s/%s", s.opts.Bucket, strings.Trim(s.opts.Prefix, "/"))
+}
+
+func (s *s3BlockStore) key(id string) string {
+	prefix := strings.Trim(s.opts.Prefix, "/")
+	if prefix == "" {
+		return id
+	}
+
+	return prefix + "/" + id
+}
+
+func (s *s3BlockStore) Put(id string, data []byte) (string, error) {
+	key := s.key(id)
+	resp, err := s.do(http.MethodPut, key, nil, nil, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to put object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	err = s.check(id, resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to put object %s: %w", key, err)
+	}
+
+	return s.Name() + "/" + id, nil
+}
+
+func (s *s3BlockStore) Get(id string) ([]byte, error) {
+	key := s.key(id)
+	resp, err := s.do(http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	err = s.check(id, resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+func (s *s3BlockStore) GetRange(id string, offset uint64, length uint64) ([]byte, error) {
+	stat, err := s.Stat(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkRange(id, stat.Length, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	if length == 0 {
+		return []byte{}, nil
+	}
+
+	key := s.key(id)
+	headers := map[string]string{
+		"Range": fmt.Sprintf("bytes=%d-%d", offset, offset+length-1),
+	}
+	resp, err := s.do(http.MethodGet, key, nil, headers, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range of object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	err = s.check(id, resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range of object %s: %w", key, err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	// Servers that ignore the range header return the whole object
+	if resp.StatusCode == http.StatusOK {
+		if uint64(len(data)) < offset+length {
+			return nil, fmt.Errorf("object %s has %d bytes, expected at least %d", key, len(data), offset+length)
+		}
+
+		return data[offset : offset+length], nil
+	}
+
+	if uint64(len(data)) != length {
+		return nil, fmt.Errorf("range of object %s has %d bytes, expected %d", key, len(data), length)
+	}
+
+	return data, nil
+}
+
+// Delete removes the object of the block. Deleting objects is idempotent in
+// S3, so a missing block is not reported.
+func (s *s3BlockStore) Delete(id string) error {
+	key := s.key(id)
+	resp, err := s.do(http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	err = s.check(id, resp)
+	if err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+}
+
+func (s *s3BlockStore) List() ([]string, error) {
+	var ids []string
+	prefix := s.key("")
+	token := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		err = s.check("", resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		result := listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode object list: %w", err)
+		}
+
+		for _, content := range result.Contents {
+			id := strings.TrimPrefix(content.Key, prefix)
+			if id == "" || strings.Contains(id, "/") {
+				continue
+			}
+
+			ids = append(ids, id)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+
+		token = result.NextContinuationToken
+	}
+
+	return ids, nil
+}
+
+func (s *s3BlockStore) Stat(id string) (BlockStat, error) {
+	key := s.key(id)
+	resp, err := s.do(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return BlockStat{}, fmt.Errorf("failed to stat object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	err = s.check(id, resp)
+	if err != nil {
+		return BlockStat{}, fmt.Errorf("failed to stat object %s: %w", key, err)
+	}
+
+	length, err := strconv.ParseUint(resp.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return BlockStat{}, fmt.Errorf("invalid content length for object %s: %w", key, err)
+	}
+
+	return BlockStat{
+		ID:       id,
+		Location: s.Name() + "/" + id,
+		Length:   length,
+	}, nil
+}
+
+func (s *s3BlockStore) check(id string, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrBlockNotFound, id)
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+func (s *s3BlockStore) do(method string, key string, query url.Values, headers map[string]string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + s.opts.Bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = s3Escape(u.Path, false)
+	u.RawQuery = s3CanonicalQuery(query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	s.sign(req, u, body, time.Now().UTC())
+
+	return s.client.Do(req)
+}
+
+// sign adds an AWS signature version 4 authorization header to the request.
+func (s *s3BlockStore) sign(req *http.Request, u url.URL, body []byte, now time.Time) {
+	payloadHash := sha256.Sum256(body)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("Host", u.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	var names []string
+	values := map[string]string{}
+
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		names = append(names, lower)
+		values[lower] = strings.TrimSpace(req.Header.Get(name))
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + values[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		u.RawPath,
+		u.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.opts.Region)
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.opts.SecretKey), date)
+	key = hmacSHA256(key, s.opts.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.opts.AccessKey,
+		scope,
+		signedHeaders,
+		signature))
+	req.Host = u.Host
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
+
+func s3Escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	var keys []string
+
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var parts []string
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
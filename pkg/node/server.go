@@ -2,33 +2,69 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// DefaultMinBlockKeyRefreshInterval is the least time between two refreshes of
+// the block keys triggered by tokens the node can not verify.
+const DefaultMinBlockKeyRefreshInterval = 10 * time.Second
+
 type ServerOpts struct {
-	Logger            *logrus.Logger
+	Logger *logrus.Logger
+	// Host is the address of this node, node access tokens are issued for it.
+	Host              string
 	BlockService      BlockService
 	ConnectionFactory proto.ConnectionFactory
+	// ReplicationThrottle limits the bandwidth used by CopyBlock. Unlimited
+	// when nil.
+	ReplicationThrottle Throttle
+	// BlockKeys verifies the block access tokens issued by the name server.
+	BlockKeys *blocktoken.Keyring
+	// RefreshBlockKeys is called when a token is signed by an unknown key or
+	// does not match the secret of its key, which happens when the name server
+	// rotated its key or restarted since the last refresh. Optional.
+	RefreshBlockKeys func() error
+	// MinBlockKeyRefreshInterval limits how often tokens trigger
+	// RefreshBlockKeys, so that invalid tokens do not flood the name server.
+	// DefaultMinBlockKeyRefreshInterval when 0.
+	MinBlockKeyRefreshInterval time.Duration
 }
 
 func (s ServerOpts) Validate() error {
 	if s.Logger == nil {
 		return fmt.Errorf("no logger provided")
 	}
+	if s.Host == "" {
+		return fmt.Errorf("no host provided")
+	}
 	if s.BlockService == nil {
 		return fmt.Errorf("no service provided")
 	}
 	if s.ConnectionFactory == nil {
 		return fmt.Errorf("no client connection factory provided")
 	}
+	if s.BlockKeys == nil {
+		return fmt.Errorf("no block keys provided")
+	}
 	return nil
 }
 
 type server struct {
 	opts ServerOpts
 	proto.UnimplementedNodeServer
+
+	// refreshLock lets one refresh of the block keys run at a time
+	refreshLock sync.Mutex
+	lastRefresh time.Time
 }
 
 var _ proto.NodeServer = &server{}
@@ -38,12 +74,63 @@ func NewServer(opts ServerOpts) (proto.NodeServer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid options: %w", err)
 	}
+	if opts.ReplicationThrottle == nil {
+		opts.ReplicationThrottle = NewThrottle(0)
+	}
+	if opts.MinBlockKeyRefreshInterval == 0 {
+		opts.MinBlockKeyRefreshInterval = DefaultMinBlockKeyRefreshInterval
+	}
 	return &server{
 		opts: opts,
 	}, nil
 }
 
-func (s *server) GetBlockInfos(ctx context.Context, _ *proto.GetBlockInfosRequest) (*proto.GetBlockInfosResponse, error) {
+// refreshBlockKeys calls RefreshBlockKeys unless it was called less than
+// MinBlockKeyRefreshInterval ago. Concurrent calls wait for the running
+// refresh instead of starting another one.
+func (s *server) refreshBlockKeys() {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+
+	if time.Since(s.lastRefresh) < s.opts.MinBlockKeyRefreshInterval {
+		return
+	}
+	s.lastRefresh = time.Now()
+
+	err := s.opts.RefreshBlockKeys()
+	if err != nil {
+		s.opts.Logger.WithError(err).Warn("Failed to refresh block keys")
+	}
+}
+
+// authorize checks that the block access token allows op on the block.
+func (s *server) authorize(token string, blockID string, op blocktoken.Op) (blocktoken.Claims, error) {
+	claims, err := s.opts.BlockKeys.Verify(token, blockID, op)
+	stale := errors.Is(err, blocktoken.ErrUnknownKey) || errors.Is(err, blocktoken.ErrBadSignature)
+	if stale && s.opts.RefreshBlockKeys != nil {
+		s.refreshBlockKeys()
+		claims, err = s.opts.BlockKeys.Verify(token, blockID, op)
+	}
+
+	if err != nil {
+		s.opts.Logger.WithError(err).WithFields(logrus.Fields{
+			"block-id": blockID,
+			"op":       op.String(),
+		}).Warn("Block access denied")
+		return blocktoken.Claims{}, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return claims, nil
+}
+
+// GetBlockInfos lists every block of the node, which requires a node access
+// token rather than one for a block.
+func (s *server) GetBlockInfos(ctx context.Context, request *proto.GetBlockInfosRequest) (*proto.GetBlockInfosResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), s.opts.Host, blocktoken.OpAdmin)
+	if err != nil {
+		return nil, err
+	}
+
 	bis, err := s.opts.BlockService.GetBlocks()
 	if err != nil {
 		return nil, err
@@ -66,6 +153,11 @@ func (s *server) GetBlockInfos(ctx context.Context, _ *proto.GetBlockInfosReques
 }
 
 func (s *server) GetBlockInfo(ctx context.Context, request *proto.GetBlockInfoRequest) (*proto.GetBlockInfoResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), request.GetId(), blocktoken.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
 	bis, err := s.opts.BlockService.GetBlocks()
 	if err != nil {
 		return nil, err
@@ -87,6 +179,11 @@ func (s *server) GetBlockInfo(ctx context.Context, request *proto.GetBlockInfoRe
 }
 
 func (s *server) GetBlock(ctx context.Context, request *proto.GetBlockRequest) (*proto.GetBlockResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), request.GetId(), blocktoken.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
 	b, bi, err := s.opts.BlockService.ReadBlock(request.GetId())
 	if err != nil {
 		return nil, err
@@ -104,12 +201,29 @@ func (s *server) GetBlock(ctx context.Context, request *proto.GetBlockRequest) (
 }
 
 func (s *server) WriteBlock(ctx context.Context, request *proto.WriteBlockRequest) (*proto.WriteBlockResponse, error) {
-	err := s.opts.BlockService.WriteBlock(
+	claims, err := s.authorize(request.GetAccessToken(), request.GetId(), blocktoken.OpWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Path != "" && claims.Path != request.GetPath() {
+		return nil, status.Errorf(codes.PermissionDenied, "block access token is not valid for path %s", request.GetPath())
+	}
+
+	if uint64(len(request.GetData())) > claims.Length {
+		return nil, status.Errorf(codes.PermissionDenied, "block access token allows writing at most %d bytes", claims.Length)
+	}
+
+	err = s.opts.BlockService.WriteBlock(
 		request.GetId(),
 		request.GetPath(),
 		request.GetSequence(),
 		request.GetData())
 
+	if errors.Is(err, ErrNoSpace) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +232,12 @@ func (s *server) WriteBlock(ctx context.Context, request *proto.WriteBlockReques
 }
 
 func (s *server) DeleteBlock(ctx context.Context, request *proto.DeleteBlockRequest) (*proto.DeleteBlockResponse, error) {
-	err := s.opts.BlockService.DeleteBlock(request.GetId())
+	_, err := s.authorize(request.GetAccessToken(), request.GetId(), blocktoken.OpDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.opts.BlockService.DeleteBlock(request.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -126,12 +245,24 @@ func (s *server) DeleteBlock(ctx context.Context, request *proto.DeleteBlockRequ
 	return &proto.DeleteBlockResponse{}, nil
 }
 
+// CopyBlock passes its access token on to the destination, so the token must
+// allow both reading and writing the block.
 func (s *server) CopyBlock(ctx context.Context, request *proto.CopyBlockRequest) (*proto.CopyBlockResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), request.GetId(), blocktoken.OpRead|blocktoken.OpWrite)
+	if err != nil {
+		return nil, err
+	}
+
 	data, blockInfo, err := s.opts.BlockService.ReadBlock(request.GetId())
 	if err != nil {
 		return nil, fmt.Errorf("failed to read data for block id %s : %w", blockInfo.ID, err)
 	}
 
+	err = s.opts.ReplicationThrottle.Wait(ctx, uint64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("throttled copy of block id %s aborted: %w", blockInfo.ID, err)
+	}
+
 	conn, err := s.opts.ConnectionFactory.CreateConnection(request.GetDestination())
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to destination node: %w", err)
@@ -139,10 +270,11 @@ func (s *server) CopyBlock(ctx context.Context, request *proto.CopyBlockRequest)
 	defer conn.Close()
 	client := proto.NewNodeClient(conn)
 	_, err = client.WriteBlock(ctx, &proto.WriteBlockRequest{
-		Id:       blockInfo.ID,
-		Path:     blockInfo.Path,
-		Sequence: blockInfo.Sequence,
-		Data:     data,
+		Id:          blockInfo.ID,
+		Path:        blockInfo.Path,
+		Sequence:    blockInfo.Sequence,
+		Data:        data,
+		AccessToken: request.GetAccessToken(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to write data for block id %s : %w", blockInfo.ID, err)
@@ -150,3 +282,30 @@ func (s *server) CopyBlock(ctx context.Context, request *proto.CopyBlockRequest)
 
 	return &proto.CopyBlockResponse{}, nil
 }
+
+// GetReplicationRate and SetReplicationRate require a node access token.
+func (s *server) GetReplicationRate(ctx context.Context, request *proto.GetReplicationRateRequest) (*proto.GetReplicationRateResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), s.opts.Host, blocktoken.OpAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetReplicationRateResponse{BytesPerSecond: s.opts.ReplicationThrottle.Rate()}, nil
+}
+
+func (s *server) SetReplicationRate(ctx context.Context, request *proto.SetReplicationRateRequest) (*proto.SetReplicationRateResponse, error) {
+	_, err := s.authorize(request.GetAccessToken(), s.opts.Host, blocktoken.OpAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := s.opts.ReplicationThrottle.Rate()
+	s.opts.ReplicationThrottle.SetRate(request.GetBytesPerSecond())
+
+	s.opts.Logger.WithFields(logrus.Fields{
+		"previous-bytes-per-second": previous,
+		"bytes-per-second":          request.GetBytesPerSecond(),
+	}).Info("Replication rate changed")
+
+	return &proto.SetReplicationRateResponse{PreviousBytesPerSecond: previous}, nil
+}
@@ -2,26 +2,128 @@ package node_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/cirglo.com/dfs/pkg/blocktoken"
 	"github.com/cirglo.com/dfs/pkg/mocks"
 	"github.com/cirglo.com/dfs/pkg/node"
 	"github.com/cirglo.com/dfs/pkg/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var blockKeys = func() *blocktoken.Keyring {
+	keys := blocktoken.NewKeyring()
+	_, err := keys.Rotate(time.Hour)
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}()
+
+func accessToken(t *testing.T, blockID string, path string, ops blocktoken.Op) string {
+	token, err := blockKeys.Issue(blockID, path, ops, 1024, time.Minute)
+	assert.NoError(t, err)
+	return token
+}
+
 func createServer(t *testing.T, blockService *mocks.BlockService, connectionFactory *mocks.ConnectionFactory) proto.NodeServer {
 	logger := createLogger(t)
 	opts := node.ServerOpts{
 		Logger:            logger,
+		Host:              "node1:55055",
 		BlockService:      blockService,
 		ConnectionFactory: connectionFactory,
+		BlockKeys:         blockKeys,
 	}
 	server, err := node.NewServer(opts)
 	assert.NoError(t, err)
 	return server
 }
 
+func TestServer_RefreshBlockKeys(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+
+	// the node still holds a key with the id of the current key of the
+	// restarted name server, but with the secret from before the restart
+	restarted := blocktoken.NewKeyring()
+	_, err := restarted.Rotate(time.Hour)
+	assert.NoError(t, err)
+	stale := restarted.Keys()[0]
+	stale.Secret = blockKeys.Keys()[0].Secret
+	nodeKeys := blocktoken.NewKeyring()
+	nodeKeys.Set([]blocktoken.Key{stale})
+
+	refreshes := 0
+	server, err := node.NewServer(node.ServerOpts{
+		Logger:            createLogger(t),
+		Host:              "node1:55055",
+		BlockService:      blockService,
+		ConnectionFactory: connectionFactory,
+		BlockKeys:         nodeKeys,
+		RefreshBlockKeys: func() error {
+			refreshes++
+			nodeKeys.Set(restarted.Keys())
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+
+	blockService.On("DeleteBlock", "block1").Return(nil)
+
+	token, err := restarted.Issue("block1", "/path/to/block", blocktoken.OpDelete, 0, time.Minute)
+	assert.NoError(t, err)
+	_, err = server.DeleteBlock(context.Background(), &proto.DeleteBlockRequest{Id: "block1", AccessToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, refreshes)
+}
+
+func TestServer_RefreshBlockKeys_Limited(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+
+	var refreshes atomic.Int32
+	server, err := node.NewServer(node.ServerOpts{
+		Logger:            createLogger(t),
+		Host:              "node1:55055",
+		BlockService:      blockService,
+		ConnectionFactory: connectionFactory,
+		BlockKeys:         blockKeys,
+		RefreshBlockKeys: func() error {
+			refreshes.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+
+	// tokens of unknown keys do not make the node refresh on every call
+	otherKeys := blocktoken.NewKeyring()
+	_, err = otherKeys.Rotate(time.Hour)
+	assert.NoError(t, err)
+	forged, err := otherKeys.Issue("block1", "/path/to/block", blocktoken.OpRead, 0, time.Minute)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := server.GetBlock(context.Background(), &proto.GetBlockRequest{Id: "block1", AccessToken: forged})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refreshes.Load())
+}
+
 func TestServer_GetBlockInfos(t *testing.T) {
 	blockService := mocks.NewBlockService(t)
 	connectionFactory := mocks.NewConnectionFactory(t)
@@ -31,12 +133,34 @@ func TestServer_GetBlockInfos(t *testing.T) {
 		{ID: "block1", CRC: 123, Sequence: 1, Length: 100},
 	}, nil)
 
-	resp, err := server.GetBlockInfos(context.Background(), &proto.GetBlockInfosRequest{})
+	resp, err := server.GetBlockInfos(context.Background(), &proto.GetBlockInfosRequest{
+		AccessToken: accessToken(t, "node1:55055", "", blocktoken.OpAdmin),
+	})
 	assert.NoError(t, err)
 	assert.Len(t, resp.BlockInfos, 1)
 	assert.Equal(t, "block1", resp.BlockInfos[0].BlockId)
 }
 
+func TestServer_GetBlockInfos_InvalidToken(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	_, err := server.GetBlockInfos(context.Background(), &proto.GetBlockInfosRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetBlockInfos(context.Background(), &proto.GetBlockInfosRequest{
+		AccessToken: accessToken(t, "node2:55055", "", blocktoken.OpAdmin),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// a token for a block does not grant node-wide calls
+	_, err = server.GetBlockInfos(context.Background(), &proto.GetBlockInfosRequest{
+		AccessToken: accessToken(t, "node1:55055", "", blocktoken.OpRead|blocktoken.OpWrite|blocktoken.OpDelete),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestServer_GetBlockInfo(t *testing.T) {
 	blockService := mocks.NewBlockService(t)
 	connectionFactory := mocks.NewConnectionFactory(t)
@@ -46,12 +170,30 @@ func TestServer_GetBlockInfo(t *testing.T) {
 		{ID: "block1", CRC: 123, Sequence: 1, Length: 100, Path: "/path/to/block"},
 	}, nil)
 
-	resp, err := server.GetBlockInfo(context.Background(), &proto.GetBlockInfoRequest{Id: "block1"})
+	resp, err := server.GetBlockInfo(context.Background(), &proto.GetBlockInfoRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpRead),
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, "block1", resp.BlockInfo.BlockId)
 	assert.Equal(t, "/path/to/block", resp.BlockInfo.Path)
 }
 
+func TestServer_GetBlockInfo_InvalidToken(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	_, err := server.GetBlockInfo(context.Background(), &proto.GetBlockInfoRequest{Id: "block1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetBlockInfo(context.Background(), &proto.GetBlockInfoRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block2", "/path/to/block", blocktoken.OpRead),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestServer_GetBlock(t *testing.T) {
 	blockService := mocks.NewBlockService(t)
 	connectionFactory := mocks.NewConnectionFactory(t)
@@ -61,12 +203,45 @@ func TestServer_GetBlock(t *testing.T) {
 		ID: "block1", CRC: 123, Sequence: 1, Length: 100, Path: "/path/to/block",
 	}, nil)
 
-	resp, err := server.GetBlock(context.Background(), &proto.GetBlockRequest{Id: "block1"})
+	resp, err := server.GetBlock(context.Background(), &proto.GetBlockRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpRead),
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, []uint8("data"), resp.Data)
 	assert.Equal(t, "block1", resp.BlockInfo.BlockId)
 }
 
+func TestServer_GetBlock_InvalidToken(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	_, err := server.GetBlock(context.Background(), &proto.GetBlockRequest{Id: "block1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetBlock(context.Background(), &proto.GetBlockRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block2", "/path/to/block", blocktoken.OpRead),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetBlock(context.Background(), &proto.GetBlockRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpWrite),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	otherKeys := blocktoken.NewKeyring()
+	_, err = otherKeys.Rotate(time.Hour)
+	assert.NoError(t, err)
+	forged, err := otherKeys.Issue("block1", "/path/to/block", blocktoken.OpRead, 0, time.Minute)
+	assert.NoError(t, err)
+
+	_, err = server.GetBlock(context.Background(), &proto.GetBlockRequest{Id: "block1", AccessToken: forged})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestServer_WriteBlock(t *testing.T) {
 	blockService := mocks.NewBlockService(t)
 	connectionFactory := mocks.NewConnectionFactory(t)
@@ -75,12 +250,64 @@ func TestServer_WriteBlock(t *testing.T) {
 	blockService.On("WriteBlock", "block1", "/path/to/block", uint64(1), []byte("data")).Return(nil)
 
 	_, err := server.WriteBlock(context.Background(), &proto.WriteBlockRequest{
-		Id:       "block1",
-		Path:     "/path/to/block",
-		Sequence: 1,
-		Data:     []byte("data"),
+		Id:          "block1",
+		Path:        "/path/to/block",
+		Sequence:    1,
+		Data:        []byte("data"),
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpWrite),
+	})
+	assert.NoError(t, err)
+}
+
+func TestServer_WriteBlock_OtherPath(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	_, err := server.WriteBlock(context.Background(), &proto.WriteBlockRequest{
+		Id:          "block1",
+		Path:        "/other/path",
+		Sequence:    1,
+		Data:        []byte("data"),
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpWrite),
 	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_WriteBlock_TooLong(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	token, err := blockKeys.Issue("block1", "/path/to/block", blocktoken.OpWrite, 1, time.Minute)
 	assert.NoError(t, err)
+
+	_, err = server.WriteBlock(context.Background(), &proto.WriteBlockRequest{
+		Id:          "block1",
+		Path:        "/path/to/block",
+		Sequence:    1,
+		Data:        []byte("data"),
+		AccessToken: token,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_WriteBlock_NoSpace(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	blockService.On("WriteBlock", "block1", "/path/to/block", uint64(1), []byte("data")).Return(fmt.Errorf("failed to choose volume: %w", node.ErrNoSpace))
+
+	_, err := server.WriteBlock(context.Background(), &proto.WriteBlockRequest{
+		Id:          "block1",
+		Path:        "/path/to/block",
+		Sequence:    1,
+		Data:        []byte("data"),
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpWrite),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
 }
 
 func TestServer_DeleteBlock(t *testing.T) {
@@ -90,6 +317,52 @@ func TestServer_DeleteBlock(t *testing.T) {
 
 	blockService.On("DeleteBlock", "block1").Return(nil)
 
-	_, err := server.DeleteBlock(context.Background(), &proto.DeleteBlockRequest{Id: "block1"})
+	_, err := server.DeleteBlock(context.Background(), &proto.DeleteBlockRequest{
+		Id:          "block1",
+		AccessToken: accessToken(t, "block1", "/path/to/block", blocktoken.OpDelete),
+	})
+	assert.NoError(t, err)
+}
+
+func TestServer_ReplicationRate(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+	token := accessToken(t, "node1:55055", "", blocktoken.OpAdmin)
+
+	resp, err := server.GetReplicationRate(context.Background(), &proto.GetReplicationRateRequest{AccessToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), resp.BytesPerSecond)
+
+	setResp, err := server.SetReplicationRate(context.Background(), &proto.SetReplicationRateRequest{BytesPerSecond: 1024, AccessToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), setResp.PreviousBytesPerSecond)
+
+	resp, err = server.GetReplicationRate(context.Background(), &proto.GetReplicationRateRequest{AccessToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1024), resp.BytesPerSecond)
+}
+
+func TestServer_ReplicationRate_InvalidToken(t *testing.T) {
+	blockService := mocks.NewBlockService(t)
+	connectionFactory := mocks.NewConnectionFactory(t)
+	server := createServer(t, blockService, connectionFactory)
+
+	_, err := server.GetReplicationRate(context.Background(), &proto.GetReplicationRateRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.SetReplicationRate(context.Background(), &proto.SetReplicationRateRequest{BytesPerSecond: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.SetReplicationRate(context.Background(), &proto.SetReplicationRateRequest{
+		BytesPerSecond: 1,
+		AccessToken:    accessToken(t, "node2:55055", "", blocktoken.OpAdmin),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := server.GetReplicationRate(context.Background(), &proto.GetReplicationRateRequest{
+		AccessToken: accessToken(t, "node1:55055", "", blocktoken.OpAdmin),
+	})
 	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), resp.BytesPerSecond)
 }
@@ -0,0 +1,53 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrBlockNotFound = errors.New("block not found")
+var ErrNoSpace = errors.New("no space left")
+
+// BlockStore keeps the data of blocks. Each store is one volume of a node and
+// is identified by its name.
+// Delete may fail with ErrBlockNotFound for missing blocks, or succeed.
+type BlockStore interface {
+	Name() string
+	Put(id string, data []byte) (string, error)
+	Get(id string) ([]byte, error)
+	GetRange(id string, offset uint64, length uint64) ([]byte, error)
+	Delete(id string) error
+	List() ([]string, error)
+	Stat(id string) (BlockStat, error)
+}
+
+type BlockStat struct {
+	ID       string
+	Location string
+	Length   uint64
+}
+
+type Capacity struct {
+	Total     uint64
+	Available uint64
+}
+
+// CapacityReporter is implemented by stores that know how much space is left.
+type CapacityReporter interface {
+	Capacity() (Capacity, error)
+}
+
+// Relocator is implemented by stores whose block locations changed over time.
+// Relocate moves a block from a legacy location to its current one and
+// returns the new location.
+type Relocator interface {
+	Relocate(id string, location string) (string, error)
+}
+
+func checkRange(id string, size uint64, offset uint64, length uint64) error {
+	if offset > size || length > size-offset {
+		return fmt.Errorf("range %d+%d is out of bounds for block %s of length %d", offset, length, id, size)
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package node_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cirglo.com/dfs/pkg/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBlockStore(t *testing.T, store node.BlockStore) {
+	ids, err := store.List()
+	assert.NoError(t, err)
+	assert.Len(t, ids, 0)
+
+	_, err = store.Get("missing")
+	assert.ErrorIs(t, err, node.ErrBlockNotFound)
+
+	_, err = store.Stat("missing")
+	assert.ErrorIs(t, err, node.ErrBlockNotFound)
+
+	err = store.Delete("missing")
+	if err != nil {
+		assert.ErrorIs(t, err, node.ErrBlockNotFound)
+	}
+
+	location, err := store.Put("block1", []byte("hello world"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, location)
+
+	_, err = store.Put("block2", []byte("second"))
+	assert.NoError(t, err)
+
+	data, err := store.Get("block1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), data)
+
+	data, err = store.GetRange("block1", 6, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("world"), data)
+
+	data, err = store.GetRange("block1", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, data, 0)
+
+	_, err = store.GetRange("block1", 6, 6)
+	assert.Error(t, err)
+
+	stat, err := store.Stat("block1")
+	assert.NoError(t, err)
+	assert.Equal(t, "block1", stat.ID)
+	assert.Equal(t, uint64(11), stat.Length)
+	assert.Equal(t, location, stat.Location)
+
+	ids, err = store.List()
+	assert.NoError(t, err)
+	sort.Strings(ids)
+	assert.Equal(t, []string{"block1", "block2"}, ids)
+
+	err = store.Delete("block1")
+	assert.NoError(t, err)
+
+	_, err = store.Get("block1")
+	assert.ErrorIs(t, err, node.ErrBlockNotFound)
+
+	ids, err = store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"block2"}, ids)
+}
+
+func TestBlockStore_Local(t *testing.T) {
+	store, err := node.NewLocalBlockStore(createDir(t))
+	assert.NoError(t, err)
+
+	testBlockStore(t, store)
+}
+
+func TestBlockStore_Local_RemovesTempFiles(t *testing.T) {
+	dir := createDir(t)
+	store, err := node.NewLocalBlockStore(dir)
+	assert.NoError(t, err)
+
+	location, err := store.Put("block1", []byte("data"))
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(filepath.Dir(location), "block2.tmp"), []byte("da"), os.ModePerm)
+	assert.NoError(t, err)
+
+	ids, err := store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"block1"}, ids)
+
+	_, err = node.NewLocalBlockStore(dir)
+	assert.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(filepath.Dir(location), "block2.tmp"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestBlockStore_Memory(t *testing.T) {
+	testBlockStore(t, node.NewMemoryBlockStore("memory"))
+}
+
+// fakeS3 is a minimal stand-in for an S3 compatible object store.
+type fakeS3 struct {
+	lock     sync.Mutex
+	objects  map[string][]byte
+	pageSize int
+	// ignoreRange answers range requests with the whole object, cut to
+	// truncate bytes if not 0.
+	ignoreRange bool
+	truncate    int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if parts[0] != "bucket" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if len(parts) == 1 {
+		f.list(w, r)
+		return
+	}
+
+	key := parts[1]
+
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case http.MethodGet, http.MethodHead:
+		data, found := f.objects[key]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if f.ignoreRange && f.truncate > 0 && r.Method == http.MethodGet {
+			data = data[:min(f.truncate, len(data))]
+		}
+
+		var start, end int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end); err == nil && !f.ignoreRange {
+			w.Header().Set("Content-Length", fmt.Sprint(end-start+1))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(data[start : end+1])
+			return
+		}
+
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	type content struct {
+		Key string `xml:"Key"`
+	}
+	type result struct {
+		XMLName               xml.Name  `xml:"ListBucketResult"`
+		IsTruncated           bool      `xml:"IsTruncated"`
+		NextContinuationToken string    `xml:"NextContinuationToken,omitempty"`
+		Contents              []content `xml:"Contents"`
+	}
+
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, r.URL.Query().Get("prefix")) && key > r.URL.Query().Get("continuation-token") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	res := result{}
+	if len(keys) > f.pageSize {
+		keys = keys[:f.pageSize]
+		res.IsTruncated = true
+		res.NextContinuationToken = keys[len(keys)-1]
+	}
+
+	for _, key := range keys {
+		res.Contents = append(res.Contents, content{Key: key})
+	}
+
+	_ = xml.NewEncoder(w).Encode(res)
+}
+
+func TestBlockStore_S3(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, pageSize: 1}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := node.NewS3BlockStore(node.S3BlockStoreOpts{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "bucket",
+		Prefix:    "blocks",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "s3://bucket/blocks", store.Name())
+
+	testBlockStore(t, store)
+
+	_, found := fake.objects["blocks/block2"]
+	assert.True(t, found)
+}
+
+func TestBlockStore_S3_InvalidOptions(t *testing.T) {
+	_, err := node.NewS3BlockStore(node.S3BlockStoreOpts{
+		Endpoint: "ftp://localhost",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "endpoint must be an http or https url")
+}
+
+func TestBlockStore_S3_IgnoredRange(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, pageSize: 10, ignoreRange: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := node.NewS3BlockStore(node.S3BlockStoreOpts{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "bucket",
+		Prefix:    "blocks",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	assert.NoError(t, err)
+
+	_, err = store.Put("block1", []byte("hello world"))
+	assert.NoError(t, err)
+
+	data, err := store.GetRange("block1", 6, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("world"), data)
+
+	// The object is shorter than its length when the range is read
+	fake.truncate = 8
+	_, err = store.GetRange("block1", 6, 5)
+	assert.Error(t, err)
+}
+
+func TestBlockStore_S3_PrefixRequired(t *testing.T) {
+	_, err := node.NewS3BlockStore(node.S3BlockStoreOpts{
+		Endpoint:  "http://localhost",
+		Region:    "us-east-1",
+		Bucket:    "bucket",
+		Prefix:    "/",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "prefix is required")
+}
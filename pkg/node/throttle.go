@@ -0,0 +1,95 @@
+package node
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Throttle limits the rate of bytes sent. A rate of zero means unlimited.
+type Throttle interface {
+	Wait(ctx context.Context, n uint64) error
+	SetRate(bytesPerSecond uint64)
+	Rate() uint64
+}
+
+// tokenBucket is a token bucket that holds up to one second worth of tokens.
+// Requests larger than the bucket drive it into debt, which later callers
+// wait out, so large blocks are still throttled to the average rate.
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   uint64
+	tokens float64
+	last   time.Time
+}
+
+var _ Throttle = &tokenBucket{}
+
+func NewThrottle(bytesPerSecond uint64) Throttle {
+	return &tokenBucket{
+		rate:   bytesPerSecond,
+		tokens: float64(bytesPerSecond),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * float64(b.rate)
+	if b.tokens > float64(b.rate) {
+		b.tokens = float64(b.rate)
+	}
+	b.last = now
+}
+
+func (b *tokenBucket) reserve(n uint64) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.rate == 0 {
+		return 0
+	}
+
+	b.refill(time.Now())
+	b.tokens -= float64(n)
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / float64(b.rate) * float64(time.Second))
+}
+
+func (b *tokenBucket) Wait(ctx context.Context, n uint64) error {
+	delay := b.reserve(n)
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (b *tokenBucket) SetRate(bytesPerSecond uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill(time.Now())
+	b.rate = bytesPerSecond
+	if b.tokens > float64(b.rate) || b.rate == 0 {
+		b.tokens = float64(b.rate)
+	}
+}
+
+func (b *tokenBucket) Rate() uint64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.rate
+}
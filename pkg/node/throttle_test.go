@@ -0,0 +1,55 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle_Unlimited(t *testing.T) {
+	throttle := node.NewThrottle(0)
+
+	start := time.Now()
+	err := throttle.Wait(context.Background(), 1<<30)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestThrottle_Rate(t *testing.T) {
+	throttle := node.NewThrottle(1000)
+
+	start := time.Now()
+	err := throttle.Wait(context.Background(), 1000)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+	err = throttle.Wait(context.Background(), 200)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestThrottle_Cancel(t *testing.T) {
+	throttle := node.NewThrottle(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := throttle.Wait(ctx, 1000)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestThrottle_SetRate(t *testing.T) {
+	throttle := node.NewThrottle(1)
+	assert.Equal(t, uint64(1), throttle.Rate())
+
+	throttle.SetRate(0)
+	assert.Equal(t, uint64(0), throttle.Rate())
+
+	start := time.Now()
+	err := throttle.Wait(context.Background(), 1000)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
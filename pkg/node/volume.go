@@ -0,0 +1,292 @@
+package node
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+const (
+	VolumePolicyRoundRobin     = "round-robin"
+	VolumePolicyAvailableSpace = "available-space"
+
+	DefaultVolumeFailureThreshold = 10
+)
+
+type volume struct {
+	Store  BlockStore
+	Errors uint
+	Failed bool
+}
+
+type volumeSet struct {
+	lock            sync.Mutex
+	volumes         []*volume
+	policy          string
+	threshold       uint
+	reservedBytes   uint64
+	reservedPercent float64
+	next            int
+}
+
+func newVolumeSet(stores []BlockStore, policy string, threshold uint, reservedBytes uint64, reservedPercent float64) *volumeSet {
+	var volumes []*volume
+
+	for _, store := range stores {
+		volumes = append(volumes, &volume{Store: store})
+	}
+
+	if policy == "" {
+		policy = VolumePolicyRoundRobin
+	}
+
+	return &volumeSet{
+		volumes:         volumes,
+		policy:          policy,
+		threshold:       threshold,
+		reservedBytes:   reservedBytes,
+		reservedPercent: reservedPercent,
+	}
+}
+
+func (v *volumeSet) reserve(capacity Capacity) uint64 {
+	reserve := uint64(float64(capacity.Total) * v.reservedPercent / 100)
+	if v.reservedBytes > reserve {
+		reserve = v.reservedBytes
+	}
+
+	return reserve
+}
+
+// hasRoom reports whether size bytes can be written to the store without
+// eating into the reserved space. Stores that do not report their capacity
+// always have room.
+func (v *volumeSet) hasRoom(store BlockStore, size uint64) bool {
+	reporter, ok := store.(CapacityReporter)
+	if !ok {
+		return true
+	}
+
+	capacity, err := reporter.Capacity()
+	if err != nil {
+		return true
+	}
+
+	return capacity.Available >= size && capacity.Available-size >= v.reserve(capacity)
+}
+
+// full reports whether no healthy volume has room left above the reserve.
+func (v *volumeSet) full() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	for _, vol := range v.volumes {
+		if !vol.Failed && v.hasRoom(vol.Store, 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *volumeSet) available() uint64 {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	available := uint64(0)
+
+	for _, vol := range v.volumes {
+		if vol.Failed {
+			continue
+		}
+
+		reporter, ok := vol.Store.(CapacityReporter)
+		if !ok {
+			continue
+		}
+
+		capacity, err := reporter.Capacity()
+		if err != nil {
+			continue
+		}
+
+		reserve := v.reserve(capacity)
+		if capacity.Available > reserve {
+			available += capacity.Available - reserve
+		}
+	}
+
+	return available
+}
+
+func (v *volumeSet) choose(size uint64) (BlockStore, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	var healthy []*volume
+	failed := 0
+
+	for _, vol := range v.volumes {
+		if vol.Failed {
+			failed++
+			continue
+		}
+
+		if v.hasRoom(vol.Store, size) {
+			healthy = append(healthy, vol)
+		}
+	}
+
+	if failed == len(v.volumes) {
+		return nil, fmt.Errorf("no healthy volume available")
+	}
+
+	if len(healthy) == 0 {
+		return nil, fmt.Errorf("%w: all volumes are below their reserved space", ErrNoSpace)
+	}
+
+	switch v.policy {
+	case VolumePolicyAvailableSpace:
+		var best *volume
+		var bestAvailable uint64
+
+		for _, vol := range healthy {
+			reporter, ok := vol.Store.(CapacityReporter)
+			if !ok {
+				continue
+			}
+
+			capacity, err := reporter.Capacity()
+			if err != nil {
+				continue
+			}
+
+			if best == nil || capacity.Available > bestAvailable {
+				best = vol
+				bestAvailable = capacity.Available
+			}
+		}
+
+		if best == nil {
+			return nil, fmt.Errorf("could not determine free space of any volume")
+		}
+
+		return best.Store, nil
+	default:
+		vol := healthy[v.next%len(healthy)]
+		v.next++
+
+		return vol.Store, nil
+	}
+}
+
+// recordError counts an I/O error against the volume and reports whether the
+// volume just crossed the failure threshold.
+func (v *volumeSet) recordError(name string) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	for _, vol := range v.volumes {
+		if vol.Store.Name() != name || vol.Failed {
+			continue
+		}
+
+		vol.Errors++
+
+		if vol.Errors >= v.threshold {
+			vol.Failed = true
+			return true
+		}
+	}
+
+	return false
+}
+
+// fail marks the volume as failed and reports whether it was healthy before.
+func (v *volumeSet) fail(name string) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	for _, vol := range v.volumes {
+		if vol.Store.Name() == name && !vol.Failed {
+			vol.Failed = true
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *volumeSet) get(name string) (BlockStore, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	for _, vol := range v.volumes {
+		if vol.Store.Name() == name && !vol.Failed {
+			return vol.Store, true
+		}
+	}
+
+	return nil, false
+}
+
+func (v *volumeSet) healthy() []BlockStore {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	var stores []BlockStore
+
+	for _, vol := range v.volumes {
+		if !vol.Failed {
+			stores = append(stores, vol.Store)
+		}
+	}
+
+	return stores
+}
+
+// find returns the name of the volume that contains the given data file path.
+// Legacy data file paths were joined to the data directory as configured,
+// which may be relative like the default "./", so both are compared as
+// absolute paths.
+func (v *volumeSet) find(path string) (string, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+
+	for _, vol := range v.volumes {
+		dir, err := filepath.Abs(vol.Store.Name())
+		if err != nil {
+			continue
+		}
+
+		rel, err := filepath.Rel(dir, absPath)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		return vol.Store.Name(), true
+	}
+
+	return "", false
+}
+
+func diskCapacity(dir string) (Capacity, error) {
+	var stat syscall.Statfs_t
+
+	err := syscall.Statfs(dir, &stat)
+	if err != nil {
+		return Capacity{}, fmt.Errorf("could not stat file system of %s: %w", dir, err)
+	}
+
+	return Capacity{
+		Total:     stat.Blocks * uint64(stat.Bsize),
+		Available: stat.Bavail * uint64(stat.Bsize),
+	}, nil
+}
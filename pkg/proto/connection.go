@@ -10,14 +10,18 @@ type ConnectionFactory interface {
 }
 
 type insecureConnectionFactory struct {
+	opts []grpc.DialOption
 }
 
 var _ ConnectionFactory = &insecureConnectionFactory{}
 
 func (i insecureConnectionFactory) CreateConnection(target string) (*grpc.ClientConn, error) {
-	return grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, i.opts...)
+	return grpc.NewClient(target, opts...)
 }
 
-func NewInsecureConnectionFactory() ConnectionFactory {
-	return &insecureConnectionFactory{}
+// NewInsecureConnectionFactory creates connections without transport
+// security. The options are added to every connection.
+func NewInsecureConnectionFactory(opts ...grpc.DialOption) ConnectionFactory {
+	return &insecureConnectionFactory{opts: opts}
 }
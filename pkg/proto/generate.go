@@ -3,6 +3,9 @@ package proto
 //go:generate protoc --go_out=. --go-grpc_out=. nodes.proto
 //go:generate protoc --go_out=. --go-grpc_out=. names.proto
 //go:generate protoc --go_out=. --go-grpc_out=. notifications.proto
+//go:generate protoc --go_out=. --go-grpc_out=. admin.proto
+//go:generate mockery --name=AdminClient --with-expecter --dir=./ --output=../mocks --outpkg=mocks
+//go:generate mockery --name=AdminServer --with-expecter --dir=./ --output=../mocks --outpkg=mocks
 //go:generate mockery --name=ConnectionFactory --with-expecter --dir=./ --output=../mocks --outpkg=mocks
 //go:generate mockery --name=NameClient --with-expecter --dir=./ --output=../mocks --outpkg=mocks
 //go:generate mockery --name=NameServer --with-expecter --dir=./ --output=../mocks --outpkg=mocks
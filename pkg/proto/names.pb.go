@@ -22,10 +22,12 @@ const (
 )
 
 type Permission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Read          bool                   `protobuf:"varint,1,opt,name=read,proto3" json:"read,omitempty"`
-	Write         bool                   `protobuf:"varint,2,opt,name=write,proto3" json:"write,omitempty"`
-	Delete        bool                   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Read   bool                   `protobuf:"varint,1,opt,name=read,proto3" json:"read,omitempty"`
+	Write  bool                   `protobuf:"varint,2,opt,name=write,proto3" json:"write,omitempty"`
+	Delete bool                   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	// execute allows to traverse a directory
+	Execute       bool `protobuf:"varint,4,opt,name=execute,proto3" json:"execute,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +83,13 @@ func (x *Permission) GetDelete() bool {
 	return false
 }
 
+func (x *Permission) GetExecute() bool {
+	if x != nil {
+		return x.Execute
+	}
+	return false
+}
+
 type Permissions struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Owner           string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -88,6 +97,8 @@ type Permissions struct {
 	OwnerPermission *Permission            `protobuf:"bytes,3,opt,name=ownerPermission,proto3" json:"ownerPermission,omitempty"`
 	GroupPermission *Permission            `protobuf:"bytes,4,opt,name=groupPermission,proto3" json:"groupPermission,omitempty"`
 	OtherPermission *Permission            `protobuf:"bytes,5,opt,name=otherPermission,proto3" json:"otherPermission,omitempty"`
+	Sticky          bool                   `protobuf:"varint,6,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	Setgid          bool                   `protobuf:"varint,7,opt,name=setgid,proto3" json:"setgid,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -157,6 +168,20 @@ func (x *Permissions) GetOtherPermission() *Permission {
 	return nil
 }
 
+func (x *Permissions) GetSticky() bool {
+	if x != nil {
+		return x.Sticky
+	}
+	return false
+}
+
+func (x *Permissions) GetSetgid() bool {
+	if x != nil {
+		return x.Setgid
+	}
+	return false
+}
+
 type DirEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -249,6 +274,7 @@ type StatBlockInfo struct {
 	Crc           uint32                 `protobuf:"varint,5,opt,name=crc,proto3" json:"crc,omitempty"`
 	Sequence      uint64                 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Length        uint32                 `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,8,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -325,10 +351,18 @@ func (x *StatBlockInfo) GetLength() uint32 {
 	return 0
 }
 
+func (x *StatBlockInfo) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type LoginRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	User           string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	HashedPassword string                 `protobuf:"bytes,2,opt,name=hashedPassword,proto3" json:"hashedPassword,omitempty"`
+	NewPassword    string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -377,6 +411,13 @@ func (x *LoginRequest) GetHashedPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -1093,82 +1134,2004 @@ func (x *StatResponse) GetBlockInfos() []*StatBlockInfo {
 	return nil
 }
 
-var File_names_proto protoreflect.FileDescriptor
+type GetBlockAccessTokenRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Token   string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path    string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	BlockId string                 `protobuf:"bytes,3,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	Read    bool                   `protobuf:"varint,4,opt,name=read,proto3" json:"read,omitempty"`
+	Write   bool                   `protobuf:"varint,5,opt,name=write,proto3" json:"write,omitempty"`
+	Delete  bool                   `protobuf:"varint,6,opt,name=delete,proto3" json:"delete,omitempty"`
+	// length is the number of bytes to write to a new block, they are charged
+	// to the quotas before the token is issued
+	Length        uint64 `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_names_proto_rawDesc = "" +
-	"\n" +
-	"\vnames.proto\x12\x04name\"N\n" +
-	"\n" +
-	"Permission\x12\x12\n" +
-	"\x04read\x18\x01 \x01(\bR\x04read\x12\x14\n" +
-	"\x05write\x18\x02 \x01(\bR\x05write\x12\x16\n" +
-	"\x06delete\x18\x03 \x01(\bR\x06delete\"\xed\x01\n" +
-	"\vPermissions\x12\x14\n" +
-	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x14\n" +
-	"\x05group\x18\x02 \x01(\tR\x05group\x12:\n" +
-	"\x0fownerPermission\x18\x03 \x01(\v2\x10.name.PermissionR\x0fownerPermission\x12:\n" +
-	"\x0fgroupPermission\x18\x04 \x01(\v2\x10.name.PermissionR\x0fgroupPermission\x12:\n" +
-	"\x0fotherPermission\x18\x05 \x01(\v2\x10.name.PermissionR\x0fotherPermission\"\xc7\x01\n" +
-	"\bDirEntry\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
-	"\x05isDir\x18\x02 \x01(\bR\x05isDir\x123\n" +
-	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\x12\x1c\n" +
-	"\tcreatedAt\x18\x04 \x01(\x03R\tcreatedAt\x12\x1e\n" +
-	"\n" +
-	"modifiedAt\x18\x05 \x01(\x03R\n" +
-	"modifiedAt\x12\x1e\n" +
-	"\n" +
-	"accessedAt\x18\x06 \x01(\x03R\n" +
-	"accessedAt\"\x97\x01\n" +
-	"\rStatBlockInfo\x12\x12\n" +
-	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
-	"\x04port\x18\x02 \x01(\rR\x04port\x12\x18\n" +
-	"\ablockId\x18\x03 \x01(\tR\ablockId\x12\x10\n" +
-	"\x03crc\x18\x05 \x01(\rR\x03crc\x12\x1a\n" +
-	"\bsequence\x18\x06 \x01(\x04R\bsequence\x12\x16\n" +
-	"\x06length\x18\a \x01(\rR\x06length\"J\n" +
-	"\fLoginRequest\x12\x12\n" +
-	"\x04user\x18\x01 \x01(\tR\x04user\x12&\n" +
-	"\x0ehashedPassword\x18\x02 \x01(\tR\x0ehashedPassword\"9\n" +
-	"\rLoginResponse\x12\x12\n" +
-	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"%\n" +
-	"\rLogoutRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\x10\n" +
-	"\x0eLogoutResponse\"r\n" +
-	"\x11CreateFileRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\x123\n" +
-	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\"\x14\n" +
-	"\x12CreateFileResponse\"q\n" +
-	"\x10CreateDirRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\x123\n" +
-	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\"\x13\n" +
-	"\x11CreateDirResponse\"=\n" +
-	"\x11DeleteFileRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\"\x14\n" +
-	"\x12DeleteFileResponse\"<\n" +
-	"\x10DeleteDirRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\"\x13\n" +
-	"\x11DeleteDirResponse\"7\n" +
-	"\vListRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\"L\n" +
-	"\fListResponse\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\x12(\n" +
-	"\aentries\x18\x02 \x03(\v2\x0e.name.DirEntryR\aentries\"7\n" +
-	"\vStatRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\"}\n" +
-	"\fStatResponse\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\x12$\n" +
-	"\x05entry\x18\x02 \x01(\v2\x0e.name.DirEntryR\x05entry\x123\n" +
-	"\n" +
-	"blockInfos\x18\x03 \x03(\v2\x13.name.StatBlockInfoR\n" +
-	"blockInfos2\xc9\x03\n" +
+func (x *GetBlockAccessTokenRequest) Reset() {
+	*x = GetBlockAccessTokenRequest{}
+	mi := &file_names_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockAccessTokenRequest) ProtoMessage() {}
+
+func (x *GetBlockAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBlockAccessTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetBlockAccessTokenRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetBlockAccessTokenRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *GetBlockAccessTokenRequest) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *GetBlockAccessTokenRequest) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *GetBlockAccessTokenRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+func (x *GetBlockAccessTokenRequest) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type GetBlockAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockAccessTokenResponse) Reset() {
+	*x = GetBlockAccessTokenResponse{}
+	mi := &file_names_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockAccessTokenResponse) ProtoMessage() {}
+
+func (x *GetBlockAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBlockAccessTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type SetPermissionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path            string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	OwnerPermission *Permission            `protobuf:"bytes,3,opt,name=ownerPermission,proto3" json:"ownerPermission,omitempty"`
+	GroupPermission *Permission            `protobuf:"bytes,4,opt,name=groupPermission,proto3" json:"groupPermission,omitempty"`
+	OtherPermission *Permission            `protobuf:"bytes,5,opt,name=otherPermission,proto3" json:"otherPermission,omitempty"`
+	Recursive       bool                   `protobuf:"varint,6,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Sticky          bool                   `protobuf:"varint,7,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	Setgid          bool                   `protobuf:"varint,8,opt,name=setgid,proto3" json:"setgid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetPermissionRequest) Reset() {
+	*x = SetPermissionRequest{}
+	mi := &file_names_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPermissionRequest) ProtoMessage() {}
+
+func (x *SetPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPermissionRequest.ProtoReflect.Descriptor instead.
+func (*SetPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetPermissionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetPermissionRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetPermissionRequest) GetOwnerPermission() *Permission {
+	if x != nil {
+		return x.OwnerPermission
+	}
+	return nil
+}
+
+func (x *SetPermissionRequest) GetGroupPermission() *Permission {
+	if x != nil {
+		return x.GroupPermission
+	}
+	return nil
+}
+
+func (x *SetPermissionRequest) GetOtherPermission() *Permission {
+	if x != nil {
+		return x.OtherPermission
+	}
+	return nil
+}
+
+func (x *SetPermissionRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *SetPermissionRequest) GetSticky() bool {
+	if x != nil {
+		return x.Sticky
+	}
+	return false
+}
+
+func (x *SetPermissionRequest) GetSetgid() bool {
+	if x != nil {
+		return x.Setgid
+	}
+	return false
+}
+
+type SetPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPermissionResponse) Reset() {
+	*x = SetPermissionResponse{}
+	mi := &file_names_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPermissionResponse) ProtoMessage() {}
+
+func (x *SetPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPermissionResponse.ProtoReflect.Descriptor instead.
+func (*SetPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{23}
+}
+
+// Empty owner or group fields are left unchanged.
+type SetOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group         string                 `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Recursive     bool                   `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOwnerRequest) Reset() {
+	*x = SetOwnerRequest{}
+	mi := &file_names_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerRequest) ProtoMessage() {}
+
+func (x *SetOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerRequest.ProtoReflect.Descriptor instead.
+func (*SetOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetOwnerRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetOwnerRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetOwnerRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SetOwnerRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SetOwnerRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type SetOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOwnerResponse) Reset() {
+	*x = SetOwnerResponse{}
+	mi := &file_names_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerResponse) ProtoMessage() {}
+
+func (x *SetOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerResponse.ProtoReflect.Descriptor instead.
+func (*SetOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{25}
+}
+
+// ACLEntry grants a named user or group a permission. The type is user, group
+// or mask. Default entries of directories are inherited by new children.
+type ACLEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permission    *Permission            `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLEntry) Reset() {
+	*x = ACLEntry{}
+	mi := &file_names_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLEntry) ProtoMessage() {}
+
+func (x *ACLEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLEntry.ProtoReflect.Descriptor instead.
+func (*ACLEntry) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ACLEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ACLEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ACLEntry) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *ACLEntry) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+type GetACLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetACLRequest) Reset() {
+	*x = GetACLRequest{}
+	mi := &file_names_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLRequest) ProtoMessage() {}
+
+func (x *GetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLRequest.ProtoReflect.Descriptor instead.
+func (*GetACLRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetACLRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ACLEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetACLResponse) Reset() {
+	*x = GetACLResponse{}
+	mi := &file_names_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLResponse) ProtoMessage() {}
+
+func (x *GetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLResponse.ProtoReflect.Descriptor instead.
+func (*GetACLResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetACLResponse) GetEntries() []*ACLEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetACLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries       []*ACLEntry            `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetACLRequest) Reset() {
+	*x = SetACLRequest{}
+	mi := &file_names_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLRequest) ProtoMessage() {}
+
+func (x *SetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLRequest.ProtoReflect.Descriptor instead.
+func (*SetACLRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetACLRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetACLRequest) GetEntries() []*ACLEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetACLResponse) Reset() {
+	*x = SetACLResponse{}
+	mi := &file_names_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLResponse) ProtoMessage() {}
+
+func (x *SetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLResponse.ProtoReflect.Descriptor instead.
+func (*SetACLResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{30}
+}
+
+// All entries are removed when no entries are given.
+type RemoveACLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries       []*ACLEntry            `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveACLRequest) Reset() {
+	*x = RemoveACLRequest{}
+	mi := &file_names_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveACLRequest) ProtoMessage() {}
+
+func (x *RemoveACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveACLRequest.ProtoReflect.Descriptor instead.
+func (*RemoveACLRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveACLRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoveACLRequest) GetEntries() []*ACLEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RemoveACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveACLResponse) Reset() {
+	*x = RemoveACLResponse{}
+	mi := &file_names_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveACLResponse) ProtoMessage() {}
+
+func (x *RemoveACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveACLResponse.ProtoReflect.Descriptor instead.
+func (*RemoveACLResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{32}
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_names_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Session) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_names_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListSessionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_names_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_names_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RevokeSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeSessionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_names_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{37}
+}
+
+type RevokeAllSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_names_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevokeAllSessionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeAllSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsResponse) Reset() {
+	*x = RevokeAllSessionsResponse{}
+	mi := &file_names_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{39}
+}
+
+type CreateDelegationTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Operations    *Permission            `protobuf:"bytes,3,opt,name=operations,proto3" json:"operations,omitempty"`
+	Lifetime      int64                  `protobuf:"varint,4,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	Renewer       string                 `protobuf:"bytes,5,opt,name=renewer,proto3" json:"renewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDelegationTokenRequest) Reset() {
+	*x = CreateDelegationTokenRequest{}
+	mi := &file_names_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDelegationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDelegationTokenRequest) ProtoMessage() {}
+
+func (x *CreateDelegationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDelegationTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateDelegationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateDelegationTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateDelegationTokenRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CreateDelegationTokenRequest) GetOperations() *Permission {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *CreateDelegationTokenRequest) GetLifetime() int64 {
+	if x != nil {
+		return x.Lifetime
+	}
+	return 0
+}
+
+func (x *CreateDelegationTokenRequest) GetRenewer() string {
+	if x != nil {
+		return x.Renewer
+	}
+	return ""
+}
+
+type CreateDelegationTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDelegationTokenResponse) Reset() {
+	*x = CreateDelegationTokenResponse{}
+	mi := &file_names_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDelegationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDelegationTokenResponse) ProtoMessage() {}
+
+func (x *CreateDelegationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDelegationTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateDelegationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateDelegationTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateDelegationTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RenewDelegationTokenRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DelegationToken string                 `protobuf:"bytes,2,opt,name=delegationToken,proto3" json:"delegationToken,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenewDelegationTokenRequest) Reset() {
+	*x = RenewDelegationTokenRequest{}
+	mi := &file_names_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewDelegationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewDelegationTokenRequest) ProtoMessage() {}
+
+func (x *RenewDelegationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewDelegationTokenRequest.ProtoReflect.Descriptor instead.
+func (*RenewDelegationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RenewDelegationTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RenewDelegationTokenRequest) GetDelegationToken() string {
+	if x != nil {
+		return x.DelegationToken
+	}
+	return ""
+}
+
+type RenewDelegationTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     int64                  `protobuf:"varint,1,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewDelegationTokenResponse) Reset() {
+	*x = RenewDelegationTokenResponse{}
+	mi := &file_names_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewDelegationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewDelegationTokenResponse) ProtoMessage() {}
+
+func (x *RenewDelegationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewDelegationTokenResponse.ProtoReflect.Descriptor instead.
+func (*RenewDelegationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RenewDelegationTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Quota limits of 0 mean no limit. Space is counted in bytes times the
+// number of replicas.
+type Quota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     uint64                 `protobuf:"varint,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceUsed uint64                 `protobuf:"varint,2,opt,name=namespaceUsed,proto3" json:"namespaceUsed,omitempty"`
+	Space         uint64                 `protobuf:"varint,3,opt,name=space,proto3" json:"space,omitempty"`
+	SpaceUsed     uint64                 `protobuf:"varint,4,opt,name=spaceUsed,proto3" json:"spaceUsed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_names_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Quota) GetNamespace() uint64 {
+	if x != nil {
+		return x.Namespace
+	}
+	return 0
+}
+
+func (x *Quota) GetNamespaceUsed() uint64 {
+	if x != nil {
+		return x.NamespaceUsed
+	}
+	return 0
+}
+
+func (x *Quota) GetSpace() uint64 {
+	if x != nil {
+		return x.Space
+	}
+	return 0
+}
+
+func (x *Quota) GetSpaceUsed() uint64 {
+	if x != nil {
+		return x.SpaceUsed
+	}
+	return 0
+}
+
+type GetContentSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentSummaryRequest) Reset() {
+	*x = GetContentSummaryRequest{}
+	mi := &file_names_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentSummaryRequest) ProtoMessage() {}
+
+func (x *GetContentSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetContentSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetContentSummaryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetContentSummaryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetContentSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	DirCount      uint64                 `protobuf:"varint,2,opt,name=dirCount,proto3" json:"dirCount,omitempty"`
+	FileCount     uint64                 `protobuf:"varint,3,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
+	Length        uint64                 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	SpaceConsumed uint64                 `protobuf:"varint,5,opt,name=spaceConsumed,proto3" json:"spaceConsumed,omitempty"`
+	Quota         *Quota                 `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentSummaryResponse) Reset() {
+	*x = GetContentSummaryResponse{}
+	mi := &file_names_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentSummaryResponse) ProtoMessage() {}
+
+func (x *GetContentSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetContentSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetContentSummaryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetContentSummaryResponse) GetDirCount() uint64 {
+	if x != nil {
+		return x.DirCount
+	}
+	return 0
+}
+
+func (x *GetContentSummaryResponse) GetFileCount() uint64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *GetContentSummaryResponse) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GetContentSummaryResponse) GetSpaceConsumed() uint64 {
+	if x != nil {
+		return x.SpaceConsumed
+	}
+	return 0
+}
+
+func (x *GetContentSummaryResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type SetQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Namespace     uint64                 `protobuf:"varint,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Space         uint64                 `protobuf:"varint,4,opt,name=space,proto3" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuotaRequest) Reset() {
+	*x = SetQuotaRequest{}
+	mi := &file_names_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuotaRequest) ProtoMessage() {}
+
+func (x *SetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetQuotaRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetQuotaRequest) GetNamespace() uint64 {
+	if x != nil {
+		return x.Namespace
+	}
+	return 0
+}
+
+func (x *SetQuotaRequest) GetSpace() uint64 {
+	if x != nil {
+		return x.Space
+	}
+	return 0
+}
+
+type SetQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuotaResponse) Reset() {
+	*x = SetQuotaResponse{}
+	mi := &file_names_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuotaResponse) ProtoMessage() {}
+
+func (x *SetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{48}
+}
+
+type SetUserQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Space         uint64                 `protobuf:"varint,3,opt,name=space,proto3" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserQuotaRequest) Reset() {
+	*x = SetUserQuotaRequest{}
+	mi := &file_names_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserQuotaRequest) ProtoMessage() {}
+
+func (x *SetUserQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetUserQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetUserQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetUserQuotaRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SetUserQuotaRequest) GetSpace() uint64 {
+	if x != nil {
+		return x.Space
+	}
+	return 0
+}
+
+type SetUserQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserQuotaResponse) Reset() {
+	*x = SetUserQuotaResponse{}
+	mi := &file_names_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserQuotaResponse) ProtoMessage() {}
+
+func (x *SetUserQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetUserQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{50}
+}
+
+type GetUserQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserQuotaRequest) Reset() {
+	*x = GetUserQuotaRequest{}
+	mi := &file_names_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserQuotaRequest) ProtoMessage() {}
+
+func (x *GetUserQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetUserQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetUserQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUserQuotaRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetUserQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Space         uint64                 `protobuf:"varint,2,opt,name=space,proto3" json:"space,omitempty"`
+	SpaceUsed     uint64                 `protobuf:"varint,3,opt,name=spaceUsed,proto3" json:"spaceUsed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserQuotaResponse) Reset() {
+	*x = GetUserQuotaResponse{}
+	mi := &file_names_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserQuotaResponse) ProtoMessage() {}
+
+func (x *GetUserQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_names_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetUserQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_names_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUserQuotaResponse) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetUserQuotaResponse) GetSpace() uint64 {
+	if x != nil {
+		return x.Space
+	}
+	return 0
+}
+
+func (x *GetUserQuotaResponse) GetSpaceUsed() uint64 {
+	if x != nil {
+		return x.SpaceUsed
+	}
+	return 0
+}
+
+var File_names_proto protoreflect.FileDescriptor
+
+const file_names_proto_rawDesc = "" +
+	"\n" +
+	"\vnames.proto\x12\x04name\"h\n" +
+	"\n" +
+	"Permission\x12\x12\n" +
+	"\x04read\x18\x01 \x01(\bR\x04read\x12\x14\n" +
+	"\x05write\x18\x02 \x01(\bR\x05write\x12\x16\n" +
+	"\x06delete\x18\x03 \x01(\bR\x06delete\x12\x18\n" +
+	"\aexecute\x18\x04 \x01(\bR\aexecute\"\x9d\x02\n" +
+	"\vPermissions\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12:\n" +
+	"\x0fownerPermission\x18\x03 \x01(\v2\x10.name.PermissionR\x0fownerPermission\x12:\n" +
+	"\x0fgroupPermission\x18\x04 \x01(\v2\x10.name.PermissionR\x0fgroupPermission\x12:\n" +
+	"\x0fotherPermission\x18\x05 \x01(\v2\x10.name.PermissionR\x0fotherPermission\x12\x16\n" +
+	"\x06sticky\x18\x06 \x01(\bR\x06sticky\x12\x16\n" +
+	"\x06setgid\x18\a \x01(\bR\x06setgid\"\xc7\x01\n" +
+	"\bDirEntry\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05isDir\x18\x02 \x01(\bR\x05isDir\x123\n" +
+	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\x03R\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"modifiedAt\x18\x05 \x01(\x03R\n" +
+	"modifiedAt\x12\x1e\n" +
+	"\n" +
+	"accessedAt\x18\x06 \x01(\x03R\n" +
+	"accessedAt\"\xb9\x01\n" +
+	"\rStatBlockInfo\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x12\x18\n" +
+	"\ablockId\x18\x03 \x01(\tR\ablockId\x12\x10\n" +
+	"\x03crc\x18\x05 \x01(\rR\x03crc\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x04R\bsequence\x12\x16\n" +
+	"\x06length\x18\a \x01(\rR\x06length\x12 \n" +
+	"\vaccessToken\x18\b \x01(\tR\vaccessToken\"l\n" +
+	"\fLoginRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12&\n" +
+	"\x0ehashedPassword\x18\x02 \x01(\tR\x0ehashedPassword\x12 \n" +
+	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"9\n" +
+	"\rLoginResponse\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"%\n" +
+	"\rLogoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x10\n" +
+	"\x0eLogoutResponse\"r\n" +
+	"\x11CreateFileRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x123\n" +
+	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\"\x14\n" +
+	"\x12CreateFileResponse\"q\n" +
+	"\x10CreateDirRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x123\n" +
+	"\vpermissions\x18\x03 \x01(\v2\x11.name.PermissionsR\vpermissions\"\x13\n" +
+	"\x11CreateDirResponse\"=\n" +
+	"\x11DeleteFileRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\x14\n" +
+	"\x12DeleteFileResponse\"<\n" +
+	"\x10DeleteDirRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\x13\n" +
+	"\x11DeleteDirResponse\"7\n" +
+	"\vListRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"L\n" +
+	"\fListResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12(\n" +
+	"\aentries\x18\x02 \x03(\v2\x0e.name.DirEntryR\aentries\"7\n" +
+	"\vStatRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"}\n" +
+	"\fStatResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12$\n" +
+	"\x05entry\x18\x02 \x01(\v2\x0e.name.DirEntryR\x05entry\x123\n" +
+	"\n" +
+	"blockInfos\x18\x03 \x03(\v2\x13.name.StatBlockInfoR\n" +
+	"blockInfos\"\xba\x01\n" +
+	"\x1aGetBlockAccessTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\ablockId\x18\x03 \x01(\tR\ablockId\x12\x12\n" +
+	"\x04read\x18\x04 \x01(\bR\x04read\x12\x14\n" +
+	"\x05write\x18\x05 \x01(\bR\x05write\x12\x16\n" +
+	"\x06delete\x18\x06 \x01(\bR\x06delete\x12\x16\n" +
+	"\x06length\x18\a \x01(\x04R\x06length\"?\n" +
+	"\x1bGetBlockAccessTokenResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"\xc2\x02\n" +
+	"\x14SetPermissionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12:\n" +
+	"\x0fownerPermission\x18\x03 \x01(\v2\x10.name.PermissionR\x0fownerPermission\x12:\n" +
+	"\x0fgroupPermission\x18\x04 \x01(\v2\x10.name.PermissionR\x0fgroupPermission\x12:\n" +
+	"\x0fotherPermission\x18\x05 \x01(\v2\x10.name.PermissionR\x0fotherPermission\x12\x1c\n" +
+	"\trecursive\x18\x06 \x01(\bR\trecursive\x12\x16\n" +
+	"\x06sticky\x18\a \x01(\bR\x06sticky\x12\x16\n" +
+	"\x06setgid\x18\b \x01(\bR\x06setgid\"\x17\n" +
+	"\x15SetPermissionResponse\"\x85\x01\n" +
+	"\x0fSetOwnerRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x14\n" +
+	"\x05group\x18\x04 \x01(\tR\x05group\x12\x1c\n" +
+	"\trecursive\x18\x05 \x01(\bR\trecursive\"\x12\n" +
+	"\x10SetOwnerResponse\"~\n" +
+	"\bACLEntry\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\v2\x10.name.PermissionR\n" +
+	"permission\x12\x18\n" +
+	"\adefault\x18\x04 \x01(\bR\adefault\"9\n" +
+	"\rGetACLRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\":\n" +
+	"\x0eGetACLResponse\x12(\n" +
+	"\aentries\x18\x01 \x03(\v2\x0e.name.ACLEntryR\aentries\"c\n" +
+	"\rSetACLRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12(\n" +
+	"\aentries\x18\x03 \x03(\v2\x0e.name.ACLEntryR\aentries\"\x10\n" +
+	"\x0eSetACLResponse\"f\n" +
+	"\x10RemoveACLRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12(\n" +
+	"\aentries\x18\x03 \x03(\v2\x0e.name.ACLEntryR\aentries\"\x13\n" +
+	"\x11RemoveACLResponse\"U\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1c\n" +
+	"\tcreatedAt\x18\x02 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\texpiresAt\x18\x03 \x01(\x03R\texpiresAt\"+\n" +
+	"\x13ListSessionsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"A\n" +
+	"\x14ListSessionsResponse\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.name.SessionR\bsessions\"<\n" +
+	"\x14RevokeSessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"\x17\n" +
+	"\x15RevokeSessionResponse\"0\n" +
+	"\x18RevokeAllSessionsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19RevokeAllSessionsResponse\"\xb2\x01\n" +
+	"\x1cCreateDelegationTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05paths\x18\x02 \x03(\tR\x05paths\x120\n" +
+	"\n" +
+	"operations\x18\x03 \x01(\v2\x10.name.PermissionR\n" +
+	"operations\x12\x1a\n" +
+	"\blifetime\x18\x04 \x01(\x03R\blifetime\x12\x18\n" +
+	"\arenewer\x18\x05 \x01(\tR\arenewer\"S\n" +
+	"\x1dCreateDelegationTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiresAt\x18\x02 \x01(\x03R\texpiresAt\"]\n" +
+	"\x1bRenewDelegationTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12(\n" +
+	"\x0fdelegationToken\x18\x02 \x01(\tR\x0fdelegationToken\"<\n" +
+	"\x1cRenewDelegationTokenResponse\x12\x1c\n" +
+	"\texpiresAt\x18\x01 \x01(\x03R\texpiresAt\"\x7f\n" +
+	"\x05Quota\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\x04R\tnamespace\x12$\n" +
+	"\rnamespaceUsed\x18\x02 \x01(\x04R\rnamespaceUsed\x12\x14\n" +
+	"\x05space\x18\x03 \x01(\x04R\x05space\x12\x1c\n" +
+	"\tspaceUsed\x18\x04 \x01(\x04R\tspaceUsed\"D\n" +
+	"\x18GetContentSummaryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\xca\x01\n" +
+	"\x19GetContentSummaryResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bdirCount\x18\x02 \x01(\x04R\bdirCount\x12\x1c\n" +
+	"\tfileCount\x18\x03 \x01(\x04R\tfileCount\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x04R\x06length\x12$\n" +
+	"\rspaceConsumed\x18\x05 \x01(\x04R\rspaceConsumed\x12!\n" +
+	"\x05quota\x18\x06 \x01(\v2\v.name.QuotaR\x05quota\"o\n" +
+	"\x0fSetQuotaRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\x04R\tnamespace\x12\x14\n" +
+	"\x05space\x18\x04 \x01(\x04R\x05space\"\x12\n" +
+	"\x10SetQuotaResponse\"U\n" +
+	"\x13SetUserQuotaRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x14\n" +
+	"\x05space\x18\x03 \x01(\x04R\x05space\"\x16\n" +
+	"\x14SetUserQuotaResponse\"?\n" +
+	"\x13GetUserQuotaRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\"^\n" +
+	"\x14GetUserQuotaResponse\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
+	"\x05space\x18\x02 \x01(\x04R\x05space\x12\x1c\n" +
+	"\tspaceUsed\x18\x03 \x01(\x04R\tspaceUsed2\x99\f\n" +
 	"\x04Name\x120\n" +
 	"\x05Login\x12\x12.name.LoginRequest\x1a\x13.name.LoginResponse\x123\n" +
 	"\x06Logout\x12\x13.name.LogoutRequest\x1a\x14.name.LogoutResponse\x12?\n" +
@@ -1179,7 +3142,22 @@ const file_names_proto_rawDesc = "" +
 	"DeleteFile\x12\x17.name.DeleteFileRequest\x1a\x18.name.DeleteFileResponse\x12<\n" +
 	"\tDeleteDir\x12\x16.name.DeleteDirRequest\x1a\x17.name.DeleteDirResponse\x12-\n" +
 	"\x04List\x12\x11.name.ListRequest\x1a\x12.name.ListResponse\x12-\n" +
-	"\x04Stat\x12\x11.name.StatRequest\x1a\x12.name.StatResponseB\n" +
+	"\x04Stat\x12\x11.name.StatRequest\x1a\x12.name.StatResponse\x12Z\n" +
+	"\x13GetBlockAccessToken\x12 .name.GetBlockAccessTokenRequest\x1a!.name.GetBlockAccessTokenResponse\x12H\n" +
+	"\rSetPermission\x12\x1a.name.SetPermissionRequest\x1a\x1b.name.SetPermissionResponse\x129\n" +
+	"\bSetOwner\x12\x15.name.SetOwnerRequest\x1a\x16.name.SetOwnerResponse\x123\n" +
+	"\x06GetACL\x12\x13.name.GetACLRequest\x1a\x14.name.GetACLResponse\x123\n" +
+	"\x06SetACL\x12\x13.name.SetACLRequest\x1a\x14.name.SetACLResponse\x12<\n" +
+	"\tRemoveACL\x12\x16.name.RemoveACLRequest\x1a\x17.name.RemoveACLResponse\x12E\n" +
+	"\fListSessions\x12\x19.name.ListSessionsRequest\x1a\x1a.name.ListSessionsResponse\x12H\n" +
+	"\rRevokeSession\x12\x1a.name.RevokeSessionRequest\x1a\x1b.name.RevokeSessionResponse\x12T\n" +
+	"\x11RevokeAllSessions\x12\x1e.name.RevokeAllSessionsRequest\x1a\x1f.name.RevokeAllSessionsResponse\x12`\n" +
+	"\x15CreateDelegationToken\x12\".name.CreateDelegationTokenRequest\x1a#.name.CreateDelegationTokenResponse\x12]\n" +
+	"\x14RenewDelegationToken\x12!.name.RenewDelegationTokenRequest\x1a\".name.RenewDelegationTokenResponse\x12T\n" +
+	"\x11GetContentSummary\x12\x1e.name.GetContentSummaryRequest\x1a\x1f.name.GetContentSummaryResponse\x129\n" +
+	"\bSetQuota\x12\x15.name.SetQuotaRequest\x1a\x16.name.SetQuotaResponse\x12E\n" +
+	"\fSetUserQuota\x12\x19.name.SetUserQuotaRequest\x1a\x1a.name.SetUserQuotaResponse\x12E\n" +
+	"\fGetUserQuota\x12\x19.name.GetUserQuotaRequest\x1a\x1a.name.GetUserQuotaResponseB\n" +
 	"Z\b./;protob\x06proto3"
 
 var (
@@ -1194,28 +3172,61 @@ func file_names_proto_rawDescGZIP() []byte {
 	return file_names_proto_rawDescData
 }
 
-var file_names_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_names_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_names_proto_goTypes = []any{
-	(*Permission)(nil),         // 0: name.Permission
-	(*Permissions)(nil),        // 1: name.Permissions
-	(*DirEntry)(nil),           // 2: name.DirEntry
-	(*StatBlockInfo)(nil),      // 3: name.StatBlockInfo
-	(*LoginRequest)(nil),       // 4: name.LoginRequest
-	(*LoginResponse)(nil),      // 5: name.LoginResponse
-	(*LogoutRequest)(nil),      // 6: name.LogoutRequest
-	(*LogoutResponse)(nil),     // 7: name.LogoutResponse
-	(*CreateFileRequest)(nil),  // 8: name.CreateFileRequest
-	(*CreateFileResponse)(nil), // 9: name.CreateFileResponse
-	(*CreateDirRequest)(nil),   // 10: name.CreateDirRequest
-	(*CreateDirResponse)(nil),  // 11: name.CreateDirResponse
-	(*DeleteFileRequest)(nil),  // 12: name.DeleteFileRequest
-	(*DeleteFileResponse)(nil), // 13: name.DeleteFileResponse
-	(*DeleteDirRequest)(nil),   // 14: name.DeleteDirRequest
-	(*DeleteDirResponse)(nil),  // 15: name.DeleteDirResponse
-	(*ListRequest)(nil),        // 16: name.ListRequest
-	(*ListResponse)(nil),       // 17: name.ListResponse
-	(*StatRequest)(nil),        // 18: name.StatRequest
-	(*StatResponse)(nil),       // 19: name.StatResponse
+	(*Permission)(nil),                    // 0: name.Permission
+	(*Permissions)(nil),                   // 1: name.Permissions
+	(*DirEntry)(nil),                      // 2: name.DirEntry
+	(*StatBlockInfo)(nil),                 // 3: name.StatBlockInfo
+	(*LoginRequest)(nil),                  // 4: name.LoginRequest
+	(*LoginResponse)(nil),                 // 5: name.LoginResponse
+	(*LogoutRequest)(nil),                 // 6: name.LogoutRequest
+	(*LogoutResponse)(nil),                // 7: name.LogoutResponse
+	(*CreateFileRequest)(nil),             // 8: name.CreateFileRequest
+	(*CreateFileResponse)(nil),            // 9: name.CreateFileResponse
+	(*CreateDirRequest)(nil),              // 10: name.CreateDirRequest
+	(*CreateDirResponse)(nil),             // 11: name.CreateDirResponse
+	(*DeleteFileRequest)(nil),             // 12: name.DeleteFileRequest
+	(*DeleteFileResponse)(nil),            // 13: name.DeleteFileResponse
+	(*DeleteDirRequest)(nil),              // 14: name.DeleteDirRequest
+	(*DeleteDirResponse)(nil),             // 15: name.DeleteDirResponse
+	(*ListRequest)(nil),                   // 16: name.ListRequest
+	(*ListResponse)(nil),                  // 17: name.ListResponse
+	(*StatRequest)(nil),                   // 18: name.StatRequest
+	(*StatResponse)(nil),                  // 19: name.StatResponse
+	(*GetBlockAccessTokenRequest)(nil),    // 20: name.GetBlockAccessTokenRequest
+	(*GetBlockAccessTokenResponse)(nil),   // 21: name.GetBlockAccessTokenResponse
+	(*SetPermissionRequest)(nil),          // 22: name.SetPermissionRequest
+	(*SetPermissionResponse)(nil),         // 23: name.SetPermissionResponse
+	(*SetOwnerRequest)(nil),               // 24: name.SetOwnerRequest
+	(*SetOwnerResponse)(nil),              // 25: name.SetOwnerResponse
+	(*ACLEntry)(nil),                      // 26: name.ACLEntry
+	(*GetACLRequest)(nil),                 // 27: name.GetACLRequest
+	(*GetACLResponse)(nil),                // 28: name.GetACLResponse
+	(*SetACLRequest)(nil),                 // 29: name.SetACLRequest
+	(*SetACLResponse)(nil),                // 30: name.SetACLResponse
+	(*RemoveACLRequest)(nil),              // 31: name.RemoveACLRequest
+	(*RemoveACLResponse)(nil),             // 32: name.RemoveACLResponse
+	(*Session)(nil),                       // 33: name.Session
+	(*ListSessionsRequest)(nil),           // 34: name.ListSessionsRequest
+	(*ListSessionsResponse)(nil),          // 35: name.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),          // 36: name.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),         // 37: name.RevokeSessionResponse
+	(*RevokeAllSessionsRequest)(nil),      // 38: name.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),     // 39: name.RevokeAllSessionsResponse
+	(*CreateDelegationTokenRequest)(nil),  // 40: name.CreateDelegationTokenRequest
+	(*CreateDelegationTokenResponse)(nil), // 41: name.CreateDelegationTokenResponse
+	(*RenewDelegationTokenRequest)(nil),   // 42: name.RenewDelegationTokenRequest
+	(*RenewDelegationTokenResponse)(nil),  // 43: name.RenewDelegationTokenResponse
+	(*Quota)(nil),                         // 44: name.Quota
+	(*GetContentSummaryRequest)(nil),      // 45: name.GetContentSummaryRequest
+	(*GetContentSummaryResponse)(nil),     // 46: name.GetContentSummaryResponse
+	(*SetQuotaRequest)(nil),               // 47: name.SetQuotaRequest
+	(*SetQuotaResponse)(nil),              // 48: name.SetQuotaResponse
+	(*SetUserQuotaRequest)(nil),           // 49: name.SetUserQuotaRequest
+	(*SetUserQuotaResponse)(nil),          // 50: name.SetUserQuotaResponse
+	(*GetUserQuotaRequest)(nil),           // 51: name.GetUserQuotaRequest
+	(*GetUserQuotaResponse)(nil),          // 52: name.GetUserQuotaResponse
 }
 var file_names_proto_depIdxs = []int32{
 	0,  // 0: name.Permissions.ownerPermission:type_name -> name.Permission
@@ -1227,27 +3238,67 @@ var file_names_proto_depIdxs = []int32{
 	2,  // 6: name.ListResponse.entries:type_name -> name.DirEntry
 	2,  // 7: name.StatResponse.entry:type_name -> name.DirEntry
 	3,  // 8: name.StatResponse.blockInfos:type_name -> name.StatBlockInfo
-	4,  // 9: name.Name.Login:input_type -> name.LoginRequest
-	6,  // 10: name.Name.Logout:input_type -> name.LogoutRequest
-	8,  // 11: name.Name.CreateFile:input_type -> name.CreateFileRequest
-	10, // 12: name.Name.CreateDir:input_type -> name.CreateDirRequest
-	12, // 13: name.Name.DeleteFile:input_type -> name.DeleteFileRequest
-	14, // 14: name.Name.DeleteDir:input_type -> name.DeleteDirRequest
-	16, // 15: name.Name.List:input_type -> name.ListRequest
-	18, // 16: name.Name.Stat:input_type -> name.StatRequest
-	5,  // 17: name.Name.Login:output_type -> name.LoginResponse
-	7,  // 18: name.Name.Logout:output_type -> name.LogoutResponse
-	9,  // 19: name.Name.CreateFile:output_type -> name.CreateFileResponse
-	11, // 20: name.Name.CreateDir:output_type -> name.CreateDirResponse
-	13, // 21: name.Name.DeleteFile:output_type -> name.DeleteFileResponse
-	15, // 22: name.Name.DeleteDir:output_type -> name.DeleteDirResponse
-	17, // 23: name.Name.List:output_type -> name.ListResponse
-	19, // 24: name.Name.Stat:output_type -> name.StatResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,  // 9: name.SetPermissionRequest.ownerPermission:type_name -> name.Permission
+	0,  // 10: name.SetPermissionRequest.groupPermission:type_name -> name.Permission
+	0,  // 11: name.SetPermissionRequest.otherPermission:type_name -> name.Permission
+	0,  // 12: name.ACLEntry.permission:type_name -> name.Permission
+	26, // 13: name.GetACLResponse.entries:type_name -> name.ACLEntry
+	26, // 14: name.SetACLRequest.entries:type_name -> name.ACLEntry
+	26, // 15: name.RemoveACLRequest.entries:type_name -> name.ACLEntry
+	33, // 16: name.ListSessionsResponse.sessions:type_name -> name.Session
+	0,  // 17: name.CreateDelegationTokenRequest.operations:type_name -> name.Permission
+	44, // 18: name.GetContentSummaryResponse.quota:type_name -> name.Quota
+	4,  // 19: name.Name.Login:input_type -> name.LoginRequest
+	6,  // 20: name.Name.Logout:input_type -> name.LogoutRequest
+	8,  // 21: name.Name.CreateFile:input_type -> name.CreateFileRequest
+	10, // 22: name.Name.CreateDir:input_type -> name.CreateDirRequest
+	12, // 23: name.Name.DeleteFile:input_type -> name.DeleteFileRequest
+	14, // 24: name.Name.DeleteDir:input_type -> name.DeleteDirRequest
+	16, // 25: name.Name.List:input_type -> name.ListRequest
+	18, // 26: name.Name.Stat:input_type -> name.StatRequest
+	20, // 27: name.Name.GetBlockAccessToken:input_type -> name.GetBlockAccessTokenRequest
+	22, // 28: name.Name.SetPermission:input_type -> name.SetPermissionRequest
+	24, // 29: name.Name.SetOwner:input_type -> name.SetOwnerRequest
+	27, // 30: name.Name.GetACL:input_type -> name.GetACLRequest
+	29, // 31: name.Name.SetACL:input_type -> name.SetACLRequest
+	31, // 32: name.Name.RemoveACL:input_type -> name.RemoveACLRequest
+	34, // 33: name.Name.ListSessions:input_type -> name.ListSessionsRequest
+	36, // 34: name.Name.RevokeSession:input_type -> name.RevokeSessionRequest
+	38, // 35: name.Name.RevokeAllSessions:input_type -> name.RevokeAllSessionsRequest
+	40, // 36: name.Name.CreateDelegationToken:input_type -> name.CreateDelegationTokenRequest
+	42, // 37: name.Name.RenewDelegationToken:input_type -> name.RenewDelegationTokenRequest
+	45, // 38: name.Name.GetContentSummary:input_type -> name.GetContentSummaryRequest
+	47, // 39: name.Name.SetQuota:input_type -> name.SetQuotaRequest
+	49, // 40: name.Name.SetUserQuota:input_type -> name.SetUserQuotaRequest
+	51, // 41: name.Name.GetUserQuota:input_type -> name.GetUserQuotaRequest
+	5,  // 42: name.Name.Login:output_type -> name.LoginResponse
+	7,  // 43: name.Name.Logout:output_type -> name.LogoutResponse
+	9,  // 44: name.Name.CreateFile:output_type -> name.CreateFileResponse
+	11, // 45: name.Name.CreateDir:output_type -> name.CreateDirResponse
+	13, // 46: name.Name.DeleteFile:output_type -> name.DeleteFileResponse
+	15, // 47: name.Name.DeleteDir:output_type -> name.DeleteDirResponse
+	17, // 48: name.Name.List:output_type -> name.ListResponse
+	19, // 49: name.Name.Stat:output_type -> name.StatResponse
+	21, // 50: name.Name.GetBlockAccessToken:output_type -> name.GetBlockAccessTokenResponse
+	23, // 51: name.Name.SetPermission:output_type -> name.SetPermissionResponse
+	25, // 52: name.Name.SetOwner:output_type -> name.SetOwnerResponse
+	28, // 53: name.Name.GetACL:output_type -> name.GetACLResponse
+	30, // 54: name.Name.SetACL:output_type -> name.SetACLResponse
+	32, // 55: name.Name.RemoveACL:output_type -> name.RemoveACLResponse
+	35, // 56: name.Name.ListSessions:output_type -> name.ListSessionsResponse
+	37, // 57: name.Name.RevokeSession:output_type -> name.RevokeSessionResponse
+	39, // 58: name.Name.RevokeAllSessions:output_type -> name.RevokeAllSessionsResponse
+	41, // 59: name.Name.CreateDelegationToken:output_type -> name.CreateDelegationTokenResponse
+	43, // 60: name.Name.RenewDelegationToken:output_type -> name.RenewDelegationTokenResponse
+	46, // 61: name.Name.GetContentSummary:output_type -> name.GetContentSummaryResponse
+	48, // 62: name.Name.SetQuota:output_type -> name.SetQuotaResponse
+	50, // 63: name.Name.SetUserQuota:output_type -> name.SetUserQuotaResponse
+	52, // 64: name.Name.GetUserQuota:output_type -> name.GetUserQuotaResponse
+	42, // [42:65] is the sub-list for method output_type
+	19, // [19:42] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_names_proto_init() }
@@ -1261,7 +3312,7 @@ func file_names_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_names_proto_rawDesc), len(file_names_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
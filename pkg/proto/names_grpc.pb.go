@@ -19,14 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Name_Login_FullMethodName      = "/name.Name/Login"
-	Name_Logout_FullMethodName     = "/name.Name/Logout"
-	Name_CreateFile_FullMethodName = "/name.Name/CreateFile"
-	Name_CreateDir_FullMethodName  = "/name.Name/CreateDir"
-	Name_DeleteFile_FullMethodName = "/name.Name/DeleteFile"
-	Name_DeleteDir_FullMethodName  = "/name.Name/DeleteDir"
-	Name_List_FullMethodName       = "/name.Name/List"
-	Name_Stat_FullMethodName       = "/name.Name/Stat"
+	Name_Login_FullMethodName                 = "/name.Name/Login"
+	Name_Logout_FullMethodName                = "/name.Name/Logout"
+	Name_CreateFile_FullMethodName            = "/name.Name/CreateFile"
+	Name_CreateDir_FullMethodName             = "/name.Name/CreateDir"
+	Name_DeleteFile_FullMethodName            = "/name.Name/DeleteFile"
+	Name_DeleteDir_FullMethodName             = "/name.Name/DeleteDir"
+	Name_List_FullMethodName                  = "/name.Name/List"
+	Name_Stat_FullMethodName                  = "/name.Name/Stat"
+	Name_GetBlockAccessToken_FullMethodName   = "/name.Name/GetBlockAccessToken"
+	Name_SetPermission_FullMethodName         = "/name.Name/SetPermission"
+	Name_SetOwner_FullMethodName              = "/name.Name/SetOwner"
+	Name_GetACL_FullMethodName                = "/name.Name/GetACL"
+	Name_SetACL_FullMethodName                = "/name.Name/SetACL"
+	Name_RemoveACL_FullMethodName             = "/name.Name/RemoveACL"
+	Name_ListSessions_FullMethodName          = "/name.Name/ListSessions"
+	Name_RevokeSession_FullMethodName         = "/name.Name/RevokeSession"
+	Name_RevokeAllSessions_FullMethodName     = "/name.Name/RevokeAllSessions"
+	Name_CreateDelegationToken_FullMethodName = "/name.Name/CreateDelegationToken"
+	Name_RenewDelegationToken_FullMethodName  = "/name.Name/RenewDelegationToken"
+	Name_GetContentSummary_FullMethodName     = "/name.Name/GetContentSummary"
+	Name_SetQuota_FullMethodName              = "/name.Name/SetQuota"
+	Name_SetUserQuota_FullMethodName          = "/name.Name/SetUserQuota"
+	Name_GetUserQuota_FullMethodName          = "/name.Name/GetUserQuota"
 )
 
 // NameClient is the client API for Name service.
@@ -41,6 +56,21 @@ type NameClient interface {
 	DeleteDir(ctx context.Context, in *DeleteDirRequest, opts ...grpc.CallOption) (*DeleteDirResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error)
+	GetBlockAccessToken(ctx context.Context, in *GetBlockAccessTokenRequest, opts ...grpc.CallOption) (*GetBlockAccessTokenResponse, error)
+	SetPermission(ctx context.Context, in *SetPermissionRequest, opts ...grpc.CallOption) (*SetPermissionResponse, error)
+	SetOwner(ctx context.Context, in *SetOwnerRequest, opts ...grpc.CallOption) (*SetOwnerResponse, error)
+	GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error)
+	SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error)
+	RemoveACL(ctx context.Context, in *RemoveACLRequest, opts ...grpc.CallOption) (*RemoveACLResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
+	CreateDelegationToken(ctx context.Context, in *CreateDelegationTokenRequest, opts ...grpc.CallOption) (*CreateDelegationTokenResponse, error)
+	RenewDelegationToken(ctx context.Context, in *RenewDelegationTokenRequest, opts ...grpc.CallOption) (*RenewDelegationTokenResponse, error)
+	GetContentSummary(ctx context.Context, in *GetContentSummaryRequest, opts ...grpc.CallOption) (*GetContentSummaryResponse, error)
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error)
+	SetUserQuota(ctx context.Context, in *SetUserQuotaRequest, opts ...grpc.CallOption) (*SetUserQuotaResponse, error)
+	GetUserQuota(ctx context.Context, in *GetUserQuotaRequest, opts ...grpc.CallOption) (*GetUserQuotaResponse, error)
 }
 
 type nameClient struct {
@@ -131,6 +161,156 @@ func (c *nameClient) Stat(ctx context.Context, in *StatRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *nameClient) GetBlockAccessToken(ctx context.Context, in *GetBlockAccessTokenRequest, opts ...grpc.CallOption) (*GetBlockAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockAccessTokenResponse)
+	err := c.cc.Invoke(ctx, Name_GetBlockAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) SetPermission(ctx context.Context, in *SetPermissionRequest, opts ...grpc.CallOption) (*SetPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPermissionResponse)
+	err := c.cc.Invoke(ctx, Name_SetPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) SetOwner(ctx context.Context, in *SetOwnerRequest, opts ...grpc.CallOption) (*SetOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOwnerResponse)
+	err := c.cc.Invoke(ctx, Name_SetOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetACLResponse)
+	err := c.cc.Invoke(ctx, Name_GetACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetACLResponse)
+	err := c.cc.Invoke(ctx, Name_SetACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) RemoveACL(ctx context.Context, in *RemoveACLRequest, opts ...grpc.CallOption) (*RemoveACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveACLResponse)
+	err := c.cc.Invoke(ctx, Name_RemoveACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Name_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, Name_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, Name_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) CreateDelegationToken(ctx context.Context, in *CreateDelegationTokenRequest, opts ...grpc.CallOption) (*CreateDelegationTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDelegationTokenResponse)
+	err := c.cc.Invoke(ctx, Name_CreateDelegationToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) RenewDelegationToken(ctx context.Context, in *RenewDelegationTokenRequest, opts ...grpc.CallOption) (*RenewDelegationTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewDelegationTokenResponse)
+	err := c.cc.Invoke(ctx, Name_RenewDelegationToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) GetContentSummary(ctx context.Context, in *GetContentSummaryRequest, opts ...grpc.CallOption) (*GetContentSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContentSummaryResponse)
+	err := c.cc.Invoke(ctx, Name_GetContentSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuotaResponse)
+	err := c.cc.Invoke(ctx, Name_SetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) SetUserQuota(ctx context.Context, in *SetUserQuotaRequest, opts ...grpc.CallOption) (*SetUserQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserQuotaResponse)
+	err := c.cc.Invoke(ctx, Name_SetUserQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nameClient) GetUserQuota(ctx context.Context, in *GetUserQuotaRequest, opts ...grpc.CallOption) (*GetUserQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserQuotaResponse)
+	err := c.cc.Invoke(ctx, Name_GetUserQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NameServer is the server API for Name service.
 // All implementations must embed UnimplementedNameServer
 // for forward compatibility.
@@ -143,6 +323,21 @@ type NameServer interface {
 	DeleteDir(context.Context, *DeleteDirRequest) (*DeleteDirResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	Stat(context.Context, *StatRequest) (*StatResponse, error)
+	GetBlockAccessToken(context.Context, *GetBlockAccessTokenRequest) (*GetBlockAccessTokenResponse, error)
+	SetPermission(context.Context, *SetPermissionRequest) (*SetPermissionResponse, error)
+	SetOwner(context.Context, *SetOwnerRequest) (*SetOwnerResponse, error)
+	GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error)
+	SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error)
+	RemoveACL(context.Context, *RemoveACLRequest) (*RemoveACLResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
+	CreateDelegationToken(context.Context, *CreateDelegationTokenRequest) (*CreateDelegationTokenResponse, error)
+	RenewDelegationToken(context.Context, *RenewDelegationTokenRequest) (*RenewDelegationTokenResponse, error)
+	GetContentSummary(context.Context, *GetContentSummaryRequest) (*GetContentSummaryResponse, error)
+	SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error)
+	SetUserQuota(context.Context, *SetUserQuotaRequest) (*SetUserQuotaResponse, error)
+	GetUserQuota(context.Context, *GetUserQuotaRequest) (*GetUserQuotaResponse, error)
 	mustEmbedUnimplementedNameServer()
 }
 
@@ -177,6 +372,51 @@ func (UnimplementedNameServer) List(context.Context, *ListRequest) (*ListRespons
 func (UnimplementedNameServer) Stat(context.Context, *StatRequest) (*StatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
 }
+func (UnimplementedNameServer) GetBlockAccessToken(context.Context, *GetBlockAccessTokenRequest) (*GetBlockAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockAccessToken not implemented")
+}
+func (UnimplementedNameServer) SetPermission(context.Context, *SetPermissionRequest) (*SetPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPermission not implemented")
+}
+func (UnimplementedNameServer) SetOwner(context.Context, *SetOwnerRequest) (*SetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwner not implemented")
+}
+func (UnimplementedNameServer) GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetACL not implemented")
+}
+func (UnimplementedNameServer) SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetACL not implemented")
+}
+func (UnimplementedNameServer) RemoveACL(context.Context, *RemoveACLRequest) (*RemoveACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveACL not implemented")
+}
+func (UnimplementedNameServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedNameServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedNameServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedNameServer) CreateDelegationToken(context.Context, *CreateDelegationTokenRequest) (*CreateDelegationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDelegationToken not implemented")
+}
+func (UnimplementedNameServer) RenewDelegationToken(context.Context, *RenewDelegationTokenRequest) (*RenewDelegationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewDelegationToken not implemented")
+}
+func (UnimplementedNameServer) GetContentSummary(context.Context, *GetContentSummaryRequest) (*GetContentSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentSummary not implemented")
+}
+func (UnimplementedNameServer) SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedNameServer) SetUserQuota(context.Context, *SetUserQuotaRequest) (*SetUserQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserQuota not implemented")
+}
+func (UnimplementedNameServer) GetUserQuota(context.Context, *GetUserQuotaRequest) (*GetUserQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserQuota not implemented")
+}
 func (UnimplementedNameServer) mustEmbedUnimplementedNameServer() {}
 func (UnimplementedNameServer) testEmbeddedByValue()              {}
 
@@ -342,6 +582,276 @@ func _Name_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Name_GetBlockAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).GetBlockAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_GetBlockAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).GetBlockAccessToken(ctx, req.(*GetBlockAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_SetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).SetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_SetPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).SetPermission(ctx, req.(*SetPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_SetOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).SetOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_SetOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).SetOwner(ctx, req.(*SetOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_GetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).GetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_GetACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).GetACL(ctx, req.(*GetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_SetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).SetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_SetACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).SetACL(ctx, req.(*SetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_RemoveACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).RemoveACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_RemoveACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).RemoveACL(ctx, req.(*RemoveACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_CreateDelegationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDelegationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).CreateDelegationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_CreateDelegationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).CreateDelegationToken(ctx, req.(*CreateDelegationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_RenewDelegationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewDelegationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).RenewDelegationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_RenewDelegationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).RenewDelegationToken(ctx, req.(*RenewDelegationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_GetContentSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).GetContentSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_GetContentSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).GetContentSummary(ctx, req.(*GetContentSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_SetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_SetUserQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).SetUserQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_SetUserQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).SetUserQuota(ctx, req.(*SetUserQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Name_GetUserQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NameServer).GetUserQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Name_GetUserQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NameServer).GetUserQuota(ctx, req.(*GetUserQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Name_ServiceDesc is the grpc.ServiceDesc for Name service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +891,66 @@ var Name_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stat",
 			Handler:    _Name_Stat_Handler,
 		},
+		{
+			MethodName: "GetBlockAccessToken",
+			Handler:    _Name_GetBlockAccessToken_Handler,
+		},
+		{
+			MethodName: "SetPermission",
+			Handler:    _Name_SetPermission_Handler,
+		},
+		{
+			MethodName: "SetOwner",
+			Handler:    _Name_SetOwner_Handler,
+		},
+		{
+			MethodName: "GetACL",
+			Handler:    _Name_GetACL_Handler,
+		},
+		{
+			MethodName: "SetACL",
+			Handler:    _Name_SetACL_Handler,
+		},
+		{
+			MethodName: "RemoveACL",
+			Handler:    _Name_RemoveACL_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Name_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Name_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _Name_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "CreateDelegationToken",
+			Handler:    _Name_CreateDelegationToken_Handler,
+		},
+		{
+			MethodName: "RenewDelegationToken",
+			Handler:    _Name_RenewDelegationToken_Handler,
+		},
+		{
+			MethodName: "GetContentSummary",
+			Handler:    _Name_GetContentSummary_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _Name_SetQuota_Handler,
+		},
+		{
+			MethodName: "SetUserQuota",
+			Handler:    _Name_SetUserQuota_Handler,
+		},
+		{
+			MethodName: "GetUserQuota",
+			Handler:    _Name_GetUserQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "names.proto",
@@ -0,0 +1,141 @@
+package proto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	NodeHostHeader      = "x-dfs-node-host"
+	NodeTimestampHeader = "x-dfs-node-timestamp"
+	NodeNonceHeader     = "x-dfs-node-nonce"
+	NodeSignatureHeader = "x-dfs-node-signature"
+)
+
+// DeriveNodeKey derives the key of a node from the cluster secret, so that
+// every node holds a key of its own without the name server storing them.
+func DeriveNodeKey(clusterSecret []byte, host string) []byte {
+	mac := hmac.New(sha256.New, clusterSecret)
+	mac.Write([]byte("node:" + host))
+
+	return mac.Sum(nil)
+}
+
+// DigestNodeRequest returns the hex encoded SHA-256 of the deterministic
+// encoding of req, so that a signature only holds for the request it was made
+// for.
+func DigestNodeRequest(req any) (string, error) {
+	message, ok := req.(protobuf.Message)
+	if !ok {
+		return "", fmt.Errorf("request %T is not a protobuf message", req)
+	}
+
+	data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", fmt.Errorf("could not encode request: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// SignNodeRequest signs a call of method made by the node at the given time.
+// The nonce is unique per call and digest is the DigestNodeRequest of the
+// request.
+func SignNodeRequest(key []byte, host string, method string, timestamp int64, nonce string, digest string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(host + "\n" + method + "\n" + strconv.FormatInt(timestamp, 10) + "\n" + nonce + "\n" + digest))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NodeAuthUnaryClientInterceptor signs every outgoing call with the key of
+// the node.
+func NodeAuthUnaryClientInterceptor(host string, key []byte) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		digest, err := DigestNodeRequest(req)
+		if err != nil {
+			return err
+		}
+
+		random := make([]byte, 16)
+		_, err = rand.Read(random)
+		if err != nil {
+			return fmt.Errorf("could not create nonce: %w", err)
+		}
+		nonce := hex.EncodeToString(random)
+
+		timestamp := time.Now().Unix()
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			NodeHostHeader, host,
+			NodeTimestampHeader, strconv.FormatInt(timestamp, 10),
+			NodeNonceHeader, nonce,
+			NodeSignatureHeader, SignNodeRequest(key, host, method, timestamp, nonce, digest))
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// blockKeyCipher derives the cipher sealing block keys for a node from the
+// key of the node.
+func blockKeyCipher(nodeKey []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, nodeKey)
+	mac.Write([]byte("block-keys"))
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// SealBlockKey encrypts the secret of block key id with the key of a node, so
+// that the secret is never sent in the clear to nodes without TLS.
+func SealBlockKey(nodeKey []byte, id uint64, secret []byte) ([]byte, error) {
+	aead, err := blockKeyCipher(nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not create block key cipher: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not create nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, secret, binary.BigEndian.AppendUint64(nil, id)), nil
+}
+
+// OpenBlockKey decrypts a secret sealed by SealBlockKey.
+func OpenBlockKey(nodeKey []byte, id uint64, sealed []byte) ([]byte, error) {
+	aead, err := blockKeyCipher(nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not create block key cipher: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("sealed block key %d is too short", id)
+	}
+
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], binary.BigEndian.AppendUint64(nil, id))
+	if err != nil {
+		return nil, fmt.Errorf("could not open block key %d: %w", id, err)
+	}
+
+	return secret, nil
+}
@@ -100,6 +100,7 @@ func (x *BlockInfo) GetPath() string {
 // Define a User message
 type GetBlockInfosRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +135,13 @@ func (*GetBlockInfosRequest) Descriptor() ([]byte, []int) {
 	return file_nodes_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetBlockInfosRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type GetBlockInfosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BlockInfos    []*BlockInfo           `protobuf:"bytes,1,rep,name=blockInfos,proto3" json:"blockInfos,omitempty"`
@@ -181,6 +189,7 @@ func (x *GetBlockInfosResponse) GetBlockInfos() []*BlockInfo {
 type GetBlockInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +231,13 @@ func (x *GetBlockInfoRequest) GetId() string {
 	return ""
 }
 
+func (x *GetBlockInfoRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type GetBlockInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BlockInfo     *BlockInfo             `protobuf:"bytes,1,opt,name=blockInfo,proto3" json:"blockInfo,omitempty"`
@@ -269,6 +285,7 @@ func (x *GetBlockInfoResponse) GetBlockInfo() *BlockInfo {
 type GetBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,6 +327,13 @@ func (x *GetBlockRequest) GetId() string {
 	return ""
 }
 
+func (x *GetBlockRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type GetBlockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BlockInfo     *BlockInfo             `protobuf:"bytes,1,opt,name=blockInfo,proto3" json:"blockInfo,omitempty"`
@@ -368,6 +392,7 @@ type WriteBlockRequest struct {
 	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	Sequence      uint64                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,5,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -430,6 +455,13 @@ func (x *WriteBlockRequest) GetData() []byte {
 	return nil
 }
 
+func (x *WriteBlockRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type WriteBlockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -469,6 +501,7 @@ func (*WriteBlockResponse) Descriptor() ([]byte, []int) {
 type DeleteBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -510,6 +543,13 @@ func (x *DeleteBlockRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteBlockRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type DeleteBlockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -550,6 +590,7 @@ type CopyBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,3,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -598,6 +639,13 @@ func (x *CopyBlockRequest) GetDestination() string {
 	return ""
 }
 
+func (x *CopyBlockRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type CopyBlockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -634,6 +682,190 @@ func (*CopyBlockResponse) Descriptor() ([]byte, []int) {
 	return file_nodes_proto_rawDescGZIP(), []int{12}
 }
 
+type GetReplicationRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicationRateRequest) Reset() {
+	*x = GetReplicationRateRequest{}
+	mi := &file_nodes_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationRateRequest) ProtoMessage() {}
+
+func (x *GetReplicationRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nodes_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationRateRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationRateRequest) Descriptor() ([]byte, []int) {
+	return file_nodes_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReplicationRateRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type GetReplicationRateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BytesPerSecond uint64                 `protobuf:"varint,1,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReplicationRateResponse) Reset() {
+	*x = GetReplicationRateResponse{}
+	mi := &file_nodes_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationRateResponse) ProtoMessage() {}
+
+func (x *GetReplicationRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nodes_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationRateResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationRateResponse) Descriptor() ([]byte, []int) {
+	return file_nodes_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReplicationRateResponse) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+type SetReplicationRateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BytesPerSecond uint64                 `protobuf:"varint,1,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+	AccessToken    string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetReplicationRateRequest) Reset() {
+	*x = SetReplicationRateRequest{}
+	mi := &file_nodes_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReplicationRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationRateRequest) ProtoMessage() {}
+
+func (x *SetReplicationRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nodes_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationRateRequest.ProtoReflect.Descriptor instead.
+func (*SetReplicationRateRequest) Descriptor() ([]byte, []int) {
+	return file_nodes_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetReplicationRateRequest) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *SetReplicationRateRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type SetReplicationRateResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	PreviousBytesPerSecond uint64                 `protobuf:"varint,1,opt,name=previousBytesPerSecond,proto3" json:"previousBytesPerSecond,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SetReplicationRateResponse) Reset() {
+	*x = SetReplicationRateResponse{}
+	mi := &file_nodes_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReplicationRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationRateResponse) ProtoMessage() {}
+
+func (x *SetReplicationRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nodes_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationRateResponse.ProtoReflect.Descriptor instead.
+func (*SetReplicationRateResponse) Descriptor() ([]byte, []int) {
+	return file_nodes_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetReplicationRateResponse) GetPreviousBytesPerSecond() uint64 {
+	if x != nil {
+		return x.PreviousBytesPerSecond
+	}
+	return 0
+}
+
 var File_nodes_proto protoreflect.FileDescriptor
 
 const file_nodes_proto_rawDesc = "" +
@@ -644,34 +876,49 @@ const file_nodes_proto_rawDesc = "" +
 	"\x03crc\x18\x02 \x01(\rR\x03crc\x12\x1a\n" +
 	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x16\n" +
 	"\x06length\x18\x04 \x01(\rR\x06length\x12\x12\n" +
-	"\x04path\x18\x05 \x01(\tR\x04path\"\x16\n" +
-	"\x14GetBlockInfosRequest\"H\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\"8\n" +
+	"\x14GetBlockInfosRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"H\n" +
 	"\x15GetBlockInfosResponse\x12/\n" +
 	"\n" +
 	"blockInfos\x18\x01 \x03(\v2\x0f.node.BlockInfoR\n" +
-	"blockInfos\"%\n" +
+	"blockInfos\"G\n" +
 	"\x13GetBlockInfoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vaccessToken\x18\x02 \x01(\tR\vaccessToken\"E\n" +
 	"\x14GetBlockInfoResponse\x12-\n" +
-	"\tblockInfo\x18\x01 \x01(\v2\x0f.node.BlockInfoR\tblockInfo\"!\n" +
+	"\tblockInfo\x18\x01 \x01(\v2\x0f.node.BlockInfoR\tblockInfo\"C\n" +
 	"\x0fGetBlockRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vaccessToken\x18\x02 \x01(\tR\vaccessToken\"U\n" +
 	"\x10GetBlockResponse\x12-\n" +
 	"\tblockInfo\x18\x01 \x01(\v2\x0f.node.BlockInfoR\tblockInfo\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\"g\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\x89\x01\n" +
 	"\x11WriteBlockRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
 	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\"\x14\n" +
-	"\x12WriteBlockResponse\"$\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12 \n" +
+	"\vaccessToken\x18\x05 \x01(\tR\vaccessToken\"\x14\n" +
+	"\x12WriteBlockResponse\"F\n" +
 	"\x12DeleteBlockRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteBlockResponse\"D\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vaccessToken\x18\x02 \x01(\tR\vaccessToken\"\x15\n" +
+	"\x13DeleteBlockResponse\"f\n" +
 	"\x10CopyBlockRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
-	"\vdestination\x18\x02 \x01(\tR\vdestination\"\x13\n" +
-	"\x11CopyBlockResponse2\x95\x03\n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12 \n" +
+	"\vaccessToken\x18\x03 \x01(\tR\vaccessToken\"\x13\n" +
+	"\x11CopyBlockResponse\"=\n" +
+	"\x19GetReplicationRateRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"D\n" +
+	"\x1aGetReplicationRateResponse\x12&\n" +
+	"\x0ebytesPerSecond\x18\x01 \x01(\x04R\x0ebytesPerSecond\"e\n" +
+	"\x19SetReplicationRateRequest\x12&\n" +
+	"\x0ebytesPerSecond\x18\x01 \x01(\x04R\x0ebytesPerSecond\x12 \n" +
+	"\vaccessToken\x18\x02 \x01(\tR\vaccessToken\"T\n" +
+	"\x1aSetReplicationRateResponse\x126\n" +
+	"\x16previousBytesPerSecond\x18\x01 \x01(\x04R\x16previousBytesPerSecond2\xc7\x04\n" +
 	"\x04Node\x12H\n" +
 	"\rGetBlockInfos\x12\x1a.node.GetBlockInfosRequest\x1a\x1b.node.GetBlockInfosResponse\x12E\n" +
 	"\fGetBlockInfo\x12\x19.node.GetBlockInfoRequest\x1a\x1a.node.GetBlockInfoResponse\x129\n" +
@@ -679,7 +926,9 @@ const file_nodes_proto_rawDesc = "" +
 	"\n" +
 	"WriteBlock\x12\x17.node.WriteBlockRequest\x1a\x18.node.WriteBlockResponse\x12B\n" +
 	"\vDeleteBlock\x12\x18.node.DeleteBlockRequest\x1a\x19.node.DeleteBlockResponse\x12<\n" +
-	"\tCopyBlock\x12\x16.node.CopyBlockRequest\x1a\x17.node.CopyBlockResponseB\n" +
+	"\tCopyBlock\x12\x16.node.CopyBlockRequest\x1a\x17.node.CopyBlockResponse\x12W\n" +
+	"\x12GetReplicationRate\x12\x1f.node.GetReplicationRateRequest\x1a .node.GetReplicationRateResponse\x12W\n" +
+	"\x12SetReplicationRate\x12\x1f.node.SetReplicationRateRequest\x1a .node.SetReplicationRateResponseB\n" +
 	"Z\b./;protob\x06proto3"
 
 var (
@@ -694,21 +943,25 @@ func file_nodes_proto_rawDescGZIP() []byte {
 	return file_nodes_proto_rawDescData
 }
 
-var file_nodes_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_nodes_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_nodes_proto_goTypes = []any{
-	(*BlockInfo)(nil),             // 0: node.BlockInfo
-	(*GetBlockInfosRequest)(nil),  // 1: node.GetBlockInfosRequest
-	(*GetBlockInfosResponse)(nil), // 2: node.GetBlockInfosResponse
-	(*GetBlockInfoRequest)(nil),   // 3: node.GetBlockInfoRequest
-	(*GetBlockInfoResponse)(nil),  // 4: node.GetBlockInfoResponse
-	(*GetBlockRequest)(nil),       // 5: node.GetBlockRequest
-	(*GetBlockResponse)(nil),      // 6: node.GetBlockResponse
-	(*WriteBlockRequest)(nil),     // 7: node.WriteBlockRequest
-	(*WriteBlockResponse)(nil),    // 8: node.WriteBlockResponse
-	(*DeleteBlockRequest)(nil),    // 9: node.DeleteBlockRequest
-	(*DeleteBlockResponse)(nil),   // 10: node.DeleteBlockResponse
-	(*CopyBlockRequest)(nil),      // 11: node.CopyBlockRequest
-	(*CopyBlockResponse)(nil),     // 12: node.CopyBlockResponse
+	(*BlockInfo)(nil),                  // 0: node.BlockInfo
+	(*GetBlockInfosRequest)(nil),       // 1: node.GetBlockInfosRequest
+	(*GetBlockInfosResponse)(nil),      // 2: node.GetBlockInfosResponse
+	(*GetBlockInfoRequest)(nil),        // 3: node.GetBlockInfoRequest
+	(*GetBlockInfoResponse)(nil),       // 4: node.GetBlockInfoResponse
+	(*GetBlockRequest)(nil),            // 5: node.GetBlockRequest
+	(*GetBlockResponse)(nil),           // 6: node.GetBlockResponse
+	(*WriteBlockRequest)(nil),          // 7: node.WriteBlockRequest
+	(*WriteBlockResponse)(nil),         // 8: node.WriteBlockResponse
+	(*DeleteBlockRequest)(nil),         // 9: node.DeleteBlockRequest
+	(*DeleteBlockResponse)(nil),        // 10: node.DeleteBlockResponse
+	(*CopyBlockRequest)(nil),           // 11: node.CopyBlockRequest
+	(*CopyBlockResponse)(nil),          // 12: node.CopyBlockResponse
+	(*GetReplicationRateRequest)(nil),  // 13: node.GetReplicationRateRequest
+	(*GetReplicationRateResponse)(nil), // 14: node.GetReplicationRateResponse
+	(*SetReplicationRateRequest)(nil),  // 15: node.SetReplicationRateRequest
+	(*SetReplicationRateResponse)(nil), // 16: node.SetReplicationRateResponse
 }
 var file_nodes_proto_depIdxs = []int32{
 	0,  // 0: node.GetBlockInfosResponse.blockInfos:type_name -> node.BlockInfo
@@ -720,14 +973,18 @@ var file_nodes_proto_depIdxs = []int32{
 	7,  // 6: node.Node.WriteBlock:input_type -> node.WriteBlockRequest
 	9,  // 7: node.Node.DeleteBlock:input_type -> node.DeleteBlockRequest
 	11, // 8: node.Node.CopyBlock:input_type -> node.CopyBlockRequest
-	2,  // 9: node.Node.GetBlockInfos:output_type -> node.GetBlockInfosResponse
-	4,  // 10: node.Node.GetBlockInfo:output_type -> node.GetBlockInfoResponse
-	6,  // 11: node.Node.GetBlock:output_type -> node.GetBlockResponse
-	8,  // 12: node.Node.WriteBlock:output_type -> node.WriteBlockResponse
-	10, // 13: node.Node.DeleteBlock:output_type -> node.DeleteBlockResponse
-	12, // 14: node.Node.CopyBlock:output_type -> node.CopyBlockResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
+	13, // 9: node.Node.GetReplicationRate:input_type -> node.GetReplicationRateRequest
+	15, // 10: node.Node.SetReplicationRate:input_type -> node.SetReplicationRateRequest
+	2,  // 11: node.Node.GetBlockInfos:output_type -> node.GetBlockInfosResponse
+	4,  // 12: node.Node.GetBlockInfo:output_type -> node.GetBlockInfoResponse
+	6,  // 13: node.Node.GetBlock:output_type -> node.GetBlockResponse
+	8,  // 14: node.Node.WriteBlock:output_type -> node.WriteBlockResponse
+	10, // 15: node.Node.DeleteBlock:output_type -> node.DeleteBlockResponse
+	12, // 16: node.Node.CopyBlock:output_type -> node.CopyBlockResponse
+	14, // 17: node.Node.GetReplicationRate:output_type -> node.GetReplicationRateResponse
+	16, // 18: node.Node.SetReplicationRate:output_type -> node.SetReplicationRateResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -744,7 +1001,7 @@ func file_nodes_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nodes_proto_rawDesc), len(file_nodes_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Node_GetBlockInfos_FullMethodName = "/node.Node/GetBlockInfos"
-	Node_GetBlockInfo_FullMethodName  = "/node.Node/GetBlockInfo"
-	Node_GetBlock_FullMethodName      = "/node.Node/GetBlock"
-	Node_WriteBlock_FullMethodName    = "/node.Node/WriteBlock"
-	Node_DeleteBlock_FullMethodName   = "/node.Node/DeleteBlock"
-	Node_CopyBlock_FullMethodName     = "/node.Node/CopyBlock"
+	Node_GetBlockInfos_FullMethodName      = "/node.Node/GetBlockInfos"
+	Node_GetBlockInfo_FullMethodName       = "/node.Node/GetBlockInfo"
+	Node_GetBlock_FullMethodName           = "/node.Node/GetBlock"
+	Node_WriteBlock_FullMethodName         = "/node.Node/WriteBlock"
+	Node_DeleteBlock_FullMethodName        = "/node.Node/DeleteBlock"
+	Node_CopyBlock_FullMethodName          = "/node.Node/CopyBlock"
+	Node_GetReplicationRate_FullMethodName = "/node.Node/GetReplicationRate"
+	Node_SetReplicationRate_FullMethodName = "/node.Node/SetReplicationRate"
 )
 
 // NodeClient is the client API for Node service.
@@ -37,6 +39,8 @@ type NodeClient interface {
 	WriteBlock(ctx context.Context, in *WriteBlockRequest, opts ...grpc.CallOption) (*WriteBlockResponse, error)
 	DeleteBlock(ctx context.Context, in *DeleteBlockRequest, opts ...grpc.CallOption) (*DeleteBlockResponse, error)
 	CopyBlock(ctx context.Context, in *CopyBlockRequest, opts ...grpc.CallOption) (*CopyBlockResponse, error)
+	GetReplicationRate(ctx context.Context, in *GetReplicationRateRequest, opts ...grpc.CallOption) (*GetReplicationRateResponse, error)
+	SetReplicationRate(ctx context.Context, in *SetReplicationRateRequest, opts ...grpc.CallOption) (*SetReplicationRateResponse, error)
 }
 
 type nodeClient struct {
@@ -107,6 +111,26 @@ func (c *nodeClient) CopyBlock(ctx context.Context, in *CopyBlockRequest, opts .
 	return out, nil
 }
 
+func (c *nodeClient) GetReplicationRate(ctx context.Context, in *GetReplicationRateRequest, opts ...grpc.CallOption) (*GetReplicationRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReplicationRateResponse)
+	err := c.cc.Invoke(ctx, Node_GetReplicationRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SetReplicationRate(ctx context.Context, in *SetReplicationRateRequest, opts ...grpc.CallOption) (*SetReplicationRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReplicationRateResponse)
+	err := c.cc.Invoke(ctx, Node_SetReplicationRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 // All implementations must embed UnimplementedNodeServer
 // for forward compatibility.
@@ -117,6 +141,8 @@ type NodeServer interface {
 	WriteBlock(context.Context, *WriteBlockRequest) (*WriteBlockResponse, error)
 	DeleteBlock(context.Context, *DeleteBlockRequest) (*DeleteBlockResponse, error)
 	CopyBlock(context.Context, *CopyBlockRequest) (*CopyBlockResponse, error)
+	GetReplicationRate(context.Context, *GetReplicationRateRequest) (*GetReplicationRateResponse, error)
+	SetReplicationRate(context.Context, *SetReplicationRateRequest) (*SetReplicationRateResponse, error)
 	mustEmbedUnimplementedNodeServer()
 }
 
@@ -145,6 +171,12 @@ func (UnimplementedNodeServer) DeleteBlock(context.Context, *DeleteBlockRequest)
 func (UnimplementedNodeServer) CopyBlock(context.Context, *CopyBlockRequest) (*CopyBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CopyBlock not implemented")
 }
+func (UnimplementedNodeServer) GetReplicationRate(context.Context, *GetReplicationRateRequest) (*GetReplicationRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationRate not implemented")
+}
+func (UnimplementedNodeServer) SetReplicationRate(context.Context, *SetReplicationRateRequest) (*SetReplicationRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReplicationRate not implemented")
+}
 func (UnimplementedNodeServer) mustEmbedUnimplementedNodeServer() {}
 func (UnimplementedNodeServer) testEmbeddedByValue()              {}
 
@@ -274,6 +306,42 @@ func _Node_CopyBlock_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_GetReplicationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetReplicationRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_GetReplicationRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetReplicationRate(ctx, req.(*GetReplicationRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SetReplicationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReplicationRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetReplicationRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_SetReplicationRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetReplicationRate(ctx, req.(*SetReplicationRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Node_ServiceDesc is the grpc.ServiceDesc for Node service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +373,14 @@ var Node_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CopyBlock",
 			Handler:    _Node_CopyBlock_Handler,
 		},
+		{
+			MethodName: "GetReplicationRate",
+			Handler:    _Node_GetReplicationRate_Handler,
+		},
+		{
+			MethodName: "SetReplicationRate",
+			Handler:    _Node_SetReplicationRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nodes.proto",
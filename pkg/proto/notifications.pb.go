@@ -357,6 +357,259 @@ func (*NotifyBlockRemovedResponse) Descriptor() ([]byte, []int) {
 	return file_notifications_proto_rawDescGZIP(), []int{5}
 }
 
+type NotifyNodeStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Host           string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Full           bool                   `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+	AvailableBytes uint64                 `protobuf:"varint,3,opt,name=availableBytes,proto3" json:"availableBytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotifyNodeStatusRequest) Reset() {
+	*x = NotifyNodeStatusRequest{}
+	mi := &file_notifications_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyNodeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyNodeStatusRequest) ProtoMessage() {}
+
+func (x *NotifyNodeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyNodeStatusRequest.ProtoReflect.Descriptor instead.
+func (*NotifyNodeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotifyNodeStatusRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *NotifyNodeStatusRequest) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *NotifyNodeStatusRequest) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+type NotifyNodeStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyNodeStatusResponse) Reset() {
+	*x = NotifyNodeStatusResponse{}
+	mi := &file_notifications_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyNodeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyNodeStatusResponse) ProtoMessage() {}
+
+func (x *NotifyNodeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyNodeStatusResponse.ProtoReflect.Descriptor instead.
+func (*NotifyNodeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{7}
+}
+
+type BlockKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret        []byte                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockKey) Reset() {
+	*x = BlockKey{}
+	mi := &file_notifications_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockKey) ProtoMessage() {}
+
+func (x *BlockKey) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockKey.ProtoReflect.Descriptor instead.
+func (*BlockKey) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockKey) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlockKey) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *BlockKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GetBlockKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockKeysRequest) Reset() {
+	*x = GetBlockKeysRequest{}
+	mi := &file_notifications_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockKeysRequest) ProtoMessage() {}
+
+func (x *GetBlockKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockKeysRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBlockKeysRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type GetBlockKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Keys  []*BlockKey            `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// sealed secrets are encrypted with the key of the node
+	Sealed        bool `protobuf:"varint,2,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockKeysResponse) Reset() {
+	*x = GetBlockKeysResponse{}
+	mi := &file_notifications_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockKeysResponse) ProtoMessage() {}
+
+func (x *GetBlockKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockKeysResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBlockKeysResponse) GetKeys() []*BlockKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetBlockKeysResponse) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
 var File_notifications_proto protoreflect.FileDescriptor
 
 const file_notifications_proto_rawDesc = "" +
@@ -382,11 +635,27 @@ const file_notifications_proto_rawDesc = "" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x18\n" +
 	"\ablockId\x18\x02 \x01(\tR\ablockId\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\"\x1c\n" +
-	"\x1aNotifyBlockRemovedResponse2\xc3\x02\n" +
+	"\x1aNotifyBlockRemovedResponse\"i\n" +
+	"\x17NotifyNodeStatusRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04full\x18\x02 \x01(\bR\x04full\x12&\n" +
+	"\x0eavailableBytes\x18\x03 \x01(\x04R\x0eavailableBytes\"\x1a\n" +
+	"\x18NotifyNodeStatusResponse\"P\n" +
+	"\bBlockKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\fR\x06secret\x12\x1c\n" +
+	"\texpiresAt\x18\x03 \x01(\x03R\texpiresAt\")\n" +
+	"\x13GetBlockKeysRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"Z\n" +
+	"\x14GetBlockKeysResponse\x12*\n" +
+	"\x04keys\x18\x01 \x03(\v2\x16.notification.BlockKeyR\x04keys\x12\x16\n" +
+	"\x06sealed\x18\x02 \x01(\bR\x06sealed2\xfd\x03\n" +
 	"\fNotification\x12g\n" +
 	"\x12NotifyBlockPresent\x12'.notification.NotifyBlockPresentRequest\x1a(.notification.NotifyBlockPresentResponse\x12a\n" +
 	"\x10NotifyBlockAdded\x12%.notification.NotifyBlockAddedRequest\x1a&.notification.NotifyBlockAddedResponse\x12g\n" +
-	"\x12NotifyBlockRemoved\x12'.notification.NotifyBlockRemovedRequest\x1a(.notification.NotifyBlockRemovedResponseB\n" +
+	"\x12NotifyBlockRemoved\x12'.notification.NotifyBlockRemovedRequest\x1a(.notification.NotifyBlockRemovedResponse\x12a\n" +
+	"\x10NotifyNodeStatus\x12%.notification.NotifyNodeStatusRequest\x1a&.notification.NotifyNodeStatusResponse\x12U\n" +
+	"\fGetBlockKeys\x12!.notification.GetBlockKeysRequest\x1a\".notification.GetBlockKeysResponseB\n" +
 	"Z\b./;protob\x06proto3"
 
 var (
@@ -401,7 +670,7 @@ func file_notifications_proto_rawDescGZIP() []byte {
 	return file_notifications_proto_rawDescData
 }
 
-var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_notifications_proto_goTypes = []any{
 	(*NotifyBlockPresentRequest)(nil),  // 0: notification.NotifyBlockPresentRequest
 	(*NotifyBlockPresentResponse)(nil), // 1: notification.NotifyBlockPresentResponse
@@ -409,19 +678,29 @@ var file_notifications_proto_goTypes = []any{
 	(*NotifyBlockAddedResponse)(nil),   // 3: notification.NotifyBlockAddedResponse
 	(*NotifyBlockRemovedRequest)(nil),  // 4: notification.NotifyBlockRemovedRequest
 	(*NotifyBlockRemovedResponse)(nil), // 5: notification.NotifyBlockRemovedResponse
+	(*NotifyNodeStatusRequest)(nil),    // 6: notification.NotifyNodeStatusRequest
+	(*NotifyNodeStatusResponse)(nil),   // 7: notification.NotifyNodeStatusResponse
+	(*BlockKey)(nil),                   // 8: notification.BlockKey
+	(*GetBlockKeysRequest)(nil),        // 9: notification.GetBlockKeysRequest
+	(*GetBlockKeysResponse)(nil),       // 10: notification.GetBlockKeysResponse
 }
 var file_notifications_proto_depIdxs = []int32{
-	0, // 0: notification.Notification.NotifyBlockPresent:input_type -> notification.NotifyBlockPresentRequest
-	2, // 1: notification.Notification.NotifyBlockAdded:input_type -> notification.NotifyBlockAddedRequest
-	4, // 2: notification.Notification.NotifyBlockRemoved:input_type -> notification.NotifyBlockRemovedRequest
-	1, // 3: notification.Notification.NotifyBlockPresent:output_type -> notification.NotifyBlockPresentResponse
-	3, // 4: notification.Notification.NotifyBlockAdded:output_type -> notification.NotifyBlockAddedResponse
-	5, // 5: notification.Notification.NotifyBlockRemoved:output_type -> notification.NotifyBlockRemovedResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: notification.GetBlockKeysResponse.keys:type_name -> notification.BlockKey
+	0,  // 1: notification.Notification.NotifyBlockPresent:input_type -> notification.NotifyBlockPresentRequest
+	2,  // 2: notification.Notification.NotifyBlockAdded:input_type -> notification.NotifyBlockAddedRequest
+	4,  // 3: notification.Notification.NotifyBlockRemoved:input_type -> notification.NotifyBlockRemovedRequest
+	6,  // 4: notification.Notification.NotifyNodeStatus:input_type -> notification.NotifyNodeStatusRequest
+	9,  // 5: notification.Notification.GetBlockKeys:input_type -> notification.GetBlockKeysRequest
+	1,  // 6: notification.Notification.NotifyBlockPresent:output_type -> notification.NotifyBlockPresentResponse
+	3,  // 7: notification.Notification.NotifyBlockAdded:output_type -> notification.NotifyBlockAddedResponse
+	5,  // 8: notification.Notification.NotifyBlockRemoved:output_type -> notification.NotifyBlockRemovedResponse
+	7,  // 9: notification.Notification.NotifyNodeStatus:output_type -> notification.NotifyNodeStatusResponse
+	10, // 10: notification.Notification.GetBlockKeys:output_type -> notification.GetBlockKeysResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_notifications_proto_init() }
@@ -435,7 +714,7 @@ func file_notifications_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notifications_proto_rawDesc), len(file_notifications_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
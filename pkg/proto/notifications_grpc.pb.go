@@ -22,6 +22,8 @@ const (
 	Notification_NotifyBlockPresent_FullMethodName = "/notification.Notification/NotifyBlockPresent"
 	Notification_NotifyBlockAdded_FullMethodName   = "/notification.Notification/NotifyBlockAdded"
 	Notification_NotifyBlockRemoved_FullMethodName = "/notification.Notification/NotifyBlockRemoved"
+	Notification_NotifyNodeStatus_FullMethodName   = "/notification.Notification/NotifyNodeStatus"
+	Notification_GetBlockKeys_FullMethodName       = "/notification.Notification/GetBlockKeys"
 )
 
 // NotificationClient is the client API for Notification service.
@@ -31,6 +33,8 @@ type NotificationClient interface {
 	NotifyBlockPresent(ctx context.Context, in *NotifyBlockPresentRequest, opts ...grpc.CallOption) (*NotifyBlockPresentResponse, error)
 	NotifyBlockAdded(ctx context.Context, in *NotifyBlockAddedRequest, opts ...grpc.CallOption) (*NotifyBlockAddedResponse, error)
 	NotifyBlockRemoved(ctx context.Context, in *NotifyBlockRemovedRequest, opts ...grpc.CallOption) (*NotifyBlockRemovedResponse, error)
+	NotifyNodeStatus(ctx context.Context, in *NotifyNodeStatusRequest, opts ...grpc.CallOption) (*NotifyNodeStatusResponse, error)
+	GetBlockKeys(ctx context.Context, in *GetBlockKeysRequest, opts ...grpc.CallOption) (*GetBlockKeysResponse, error)
 }
 
 type notificationClient struct {
@@ -71,6 +75,26 @@ func (c *notificationClient) NotifyBlockRemoved(ctx context.Context, in *NotifyB
 	return out, nil
 }
 
+func (c *notificationClient) NotifyNodeStatus(ctx context.Context, in *NotifyNodeStatusRequest, opts ...grpc.CallOption) (*NotifyNodeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyNodeStatusResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifyNodeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) GetBlockKeys(ctx context.Context, in *GetBlockKeysRequest, opts ...grpc.CallOption) (*GetBlockKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockKeysResponse)
+	err := c.cc.Invoke(ctx, Notification_GetBlockKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServer is the server API for Notification service.
 // All implementations must embed UnimplementedNotificationServer
 // for forward compatibility.
@@ -78,6 +102,8 @@ type NotificationServer interface {
 	NotifyBlockPresent(context.Context, *NotifyBlockPresentRequest) (*NotifyBlockPresentResponse, error)
 	NotifyBlockAdded(context.Context, *NotifyBlockAddedRequest) (*NotifyBlockAddedResponse, error)
 	NotifyBlockRemoved(context.Context, *NotifyBlockRemovedRequest) (*NotifyBlockRemovedResponse, error)
+	NotifyNodeStatus(context.Context, *NotifyNodeStatusRequest) (*NotifyNodeStatusResponse, error)
+	GetBlockKeys(context.Context, *GetBlockKeysRequest) (*GetBlockKeysResponse, error)
 	mustEmbedUnimplementedNotificationServer()
 }
 
@@ -97,6 +123,12 @@ func (UnimplementedNotificationServer) NotifyBlockAdded(context.Context, *Notify
 func (UnimplementedNotificationServer) NotifyBlockRemoved(context.Context, *NotifyBlockRemovedRequest) (*NotifyBlockRemovedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyBlockRemoved not implemented")
 }
+func (UnimplementedNotificationServer) NotifyNodeStatus(context.Context, *NotifyNodeStatusRequest) (*NotifyNodeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyNodeStatus not implemented")
+}
+func (UnimplementedNotificationServer) GetBlockKeys(context.Context, *GetBlockKeysRequest) (*GetBlockKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockKeys not implemented")
+}
 func (UnimplementedNotificationServer) mustEmbedUnimplementedNotificationServer() {}
 func (UnimplementedNotificationServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +204,42 @@ func _Notification_NotifyBlockRemoved_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notification_NotifyNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyNodeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifyNodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifyNodeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifyNodeStatus(ctx, req.(*NotifyNodeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_GetBlockKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).GetBlockKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_GetBlockKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).GetBlockKeys(ctx, req.(*GetBlockKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Notification_ServiceDesc is the grpc.ServiceDesc for Notification service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +259,14 @@ var Notification_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyBlockRemoved",
 			Handler:    _Notification_NotifyBlockRemoved_Handler,
 		},
+		{
+			MethodName: "NotifyNodeStatus",
+			Handler:    _Notification_NotifyNodeStatus_Handler,
+		},
+		{
+			MethodName: "GetBlockKeys",
+			Handler:    _Notification_GetBlockKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notifications.proto",
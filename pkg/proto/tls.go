@@ -0,0 +1,238 @@
+package proto
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const DefaultCertificateReloadInterval = 10 * time.Second
+
+type TLSOpts struct {
+	CertFile string
+	KeyFile  string
+	// CAFile holds the certificates of the authorities trusted for peers.
+	// The system roots are used for servers when empty.
+	CAFile string
+	// VerifyClientCertificates makes servers require client certificates
+	// issued by the CA.
+	VerifyClientCertificates bool
+	// ReloadInterval is how often the files are checked for changes.
+	ReloadInterval time.Duration
+}
+
+func (o *TLSOpts) Validate() error {
+	if (o.CertFile == "") != (o.KeyFile == "") {
+		return fmt.Errorf("certificate and key must be given together")
+	}
+	if o.VerifyClientCertificates && o.CAFile == "" {
+		return fmt.Errorf("ca is required to verify client certificates")
+	}
+	if o.ReloadInterval < 0 {
+		return fmt.Errorf("reload interval must not be negative")
+	}
+
+	return nil
+}
+
+// Certificates holds the certificate and the CA of a TLSOpts and reloads them
+// when one of the files changed, so that renewed certificates are used
+// without a restart.
+type Certificates struct {
+	opts        TLSOpts
+	lock        sync.Mutex
+	certificate *tls.Certificate
+	pool        *x509.CertPool
+	modTimes    map[string]time.Time
+	checkedAt   time.Time
+}
+
+func NewCertificates(opts TLSOpts) (*Certificates, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("options are invalid: %w", err)
+	}
+
+	if opts.ReloadInterval == 0 {
+		opts.ReloadInterval = DefaultCertificateReloadInterval
+	}
+
+	c := &Certificates{opts: opts}
+
+	err = c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Certificates) files() []string {
+	var files []string
+
+	for _, file := range []string{c.opts.CertFile, c.opts.KeyFile, c.opts.CAFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+func (c *Certificates) load() error {
+	modTimes := map[string]time.Time{}
+
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("could not stat %s: %w", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	var certificate *tls.Certificate
+	if c.opts.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(c.opts.CertFile, c.opts.KeyFile)
+		if err != nil {
+			return fmt.Errorf("could not load certificate %s: %w", c.opts.CertFile, err)
+		}
+		certificate = &loaded
+	}
+
+	var pool *x509.CertPool
+	if c.opts.CAFile != "" {
+		data, err := os.ReadFile(c.opts.CAFile)
+		if err != nil {
+			return fmt.Errorf("could not read ca %s: %w", c.opts.CAFile, err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in ca %s", c.opts.CAFile)
+		}
+	}
+
+	c.certificate = certificate
+	c.pool = pool
+	c.modTimes = modTimes
+
+	return nil
+}
+
+func (c *Certificates) changed() bool {
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false
+		}
+		if !info.ModTime().Equal(c.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// current returns the certificate and the CA, reloading them when the files
+// changed. The previous ones are kept when the new files cannot be loaded,
+// for example while only one of them has been replaced yet.
+func (c *Certificates) current() (*tls.Certificate, *x509.CertPool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.checkedAt) >= c.opts.ReloadInterval {
+		c.checkedAt = now
+
+		if c.changed() {
+			certificate, pool, modTimes := c.certificate, c.pool, c.modTimes
+			err := c.load()
+			if err != nil {
+				c.certificate, c.pool, c.modTimes = certificate, pool, modTimes
+			}
+		}
+	}
+
+	return c.certificate, c.pool
+}
+
+// ServerConfig returns a configuration that picks up reloaded certificates
+// for every new connection.
+func (c *Certificates) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certificate, pool := c.current()
+			if certificate == nil {
+				return nil, fmt.Errorf("no server certificate configured")
+			}
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2"},
+				Certificates: []tls.Certificate{*certificate},
+				ClientCAs:    pool,
+				ClientAuth:   tls.NoClientCert,
+			}
+			switch {
+			case c.opts.VerifyClientCertificates:
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			case pool != nil:
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// ClientConfig returns a configuration that presents the certificate, if
+// any, to servers.
+func (c *Certificates) ClientConfig() *tls.Config {
+	_, pool := c.current()
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, _ := c.current()
+			if certificate == nil {
+				return &tls.Certificate{}, nil
+			}
+
+			return certificate, nil
+		},
+	}
+}
+
+// ServerOption returns the credentials for a grpc.Server.
+func (c *Certificates) ServerOption() grpc.ServerOption {
+	return grpc.Creds(credentials.NewTLS(c.ServerConfig()))
+}
+
+type tlsConnectionFactory struct {
+	certificates *Certificates
+	opts         []grpc.DialOption
+}
+
+var _ ConnectionFactory = &tlsConnectionFactory{}
+
+// CreateConnection uses the CA loaded last, so that connections created after
+// a reload trust the new CA.
+func (t tlsConnectionFactory) CreateConnection(target string) (*grpc.ClientConn, error) {
+	creds := credentials.NewTLS(t.certificates.ClientConfig())
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, t.opts...)
+	return grpc.NewClient(target, opts...)
+}
+
+// NewTLSConnectionFactory creates connections secured by TLS. The options are
+// added to every connection.
+func NewTLSConnectionFactory(certificates *Certificates, opts ...grpc.DialOption) ConnectionFactory {
+	return &tlsConnectionFactory{certificates: certificates, opts: opts}
+}
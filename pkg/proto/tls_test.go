@@ -0,0 +1,195 @@
+package proto_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirglo.com/dfs/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "dfs-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return testCA{certificate: certificate, key: key}
+}
+
+func (ca testCA) writeCA(t *testing.T, path string) {
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.certificate.Raw})
+	assert.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+func (ca testCA) writeCertificate(t *testing.T, serial int64, host string, certPath string, keyPath string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+// handshake connects a client and a server over loopback and returns the
+// certificates each side saw.
+func handshake(t *testing.T, server *proto.Certificates, client *proto.Certificates) (*x509.Certificate, *x509.Certificate, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	assert.NoError(t, err)
+	defer clientConn.Close()
+
+	serverConn, err := listener.Accept()
+	assert.NoError(t, err)
+	defer serverConn.Close()
+
+	clientConfig := client.ClientConfig()
+	clientConfig.ServerName = "localhost"
+	clientConfig.NextProtos = []string{"h2"}
+
+	serverTLS := tls.Server(serverConn, server.ServerConfig())
+	clientTLS := tls.Client(clientConn, clientConfig)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- serverTLS.Handshake()
+	}()
+
+	err = clientTLS.Handshake()
+	if err != nil {
+		clientConn.Close()
+	}
+	serverErr := <-errs
+	if err != nil {
+		return nil, nil, err
+	}
+	if serverErr != nil {
+		return nil, nil, serverErr
+	}
+
+	var clientCertificate *x509.Certificate
+	if peers := serverTLS.ConnectionState().PeerCertificates; len(peers) > 0 {
+		clientCertificate = peers[0]
+	}
+
+	return clientTLS.ConnectionState().PeerCertificates[0], clientCertificate, nil
+}
+
+func TestCertificates_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	ca.writeCA(t, caPath)
+	ca.writeCertificate(t, 2, "localhost", filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"))
+	ca.writeCertificate(t, 3, "node1", filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key"))
+
+	server, err := proto.NewCertificates(proto.TLSOpts{
+		CertFile:                 filepath.Join(dir, "server.pem"),
+		KeyFile:                  filepath.Join(dir, "server.key"),
+		CAFile:                   caPath,
+		VerifyClientCertificates: true,
+	})
+	assert.NoError(t, err)
+
+	client, err := proto.NewCertificates(proto.TLSOpts{
+		CertFile: filepath.Join(dir, "client.pem"),
+		KeyFile:  filepath.Join(dir, "client.key"),
+		CAFile:   caPath,
+	})
+	assert.NoError(t, err)
+
+	serverCertificate, clientCertificate, err := handshake(t, server, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", serverCertificate.Subject.CommonName)
+	assert.Equal(t, "node1", clientCertificate.Subject.CommonName)
+
+	anonymous, err := proto.NewCertificates(proto.TLSOpts{CAFile: caPath})
+	assert.NoError(t, err)
+
+	_, _, err = handshake(t, server, anonymous)
+	assert.Error(t, err)
+}
+
+func TestCertificates_Reload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	certPath := filepath.Join(dir, "server.pem")
+	keyPath := filepath.Join(dir, "server.key")
+	ca.writeCA(t, caPath)
+	ca.writeCertificate(t, 2, "localhost", certPath, keyPath)
+
+	server, err := proto.NewCertificates(proto.TLSOpts{
+		CertFile:       certPath,
+		KeyFile:        keyPath,
+		ReloadInterval: time.Nanosecond,
+	})
+	assert.NoError(t, err)
+
+	client, err := proto.NewCertificates(proto.TLSOpts{CAFile: caPath})
+	assert.NoError(t, err)
+
+	serverCertificate, _, err := handshake(t, server, client)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), serverCertificate.SerialNumber.Int64())
+
+	ca.writeCertificate(t, 4, "localhost", certPath, keyPath)
+	later := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(certPath, later, later))
+
+	serverCertificate, _, err = handshake(t, server, client)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), serverCertificate.SerialNumber.Int64())
+}
+
+func TestTLSOpts_Validate(t *testing.T) {
+	_, err := proto.NewCertificates(proto.TLSOpts{CertFile: "server.pem"})
+	assert.Error(t, err)
+
+	_, err = proto.NewCertificates(proto.TLSOpts{VerifyClientCertificates: true})
+	assert.Error(t, err)
+}
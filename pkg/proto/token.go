@@ -0,0 +1,27 @@
+package proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	AuthorizationHeader = "authorization"
+	BearerPrefix        = "Bearer "
+	// DoAsHeader names the user a trusted service account acts for.
+	DoAsHeader = "x-dfs-doas"
+)
+
+// WithBearerToken returns a context that sends the token as bearer token in
+// the authorization metadata of outgoing calls.
+func WithBearerToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, AuthorizationHeader, BearerPrefix+token)
+}
+
+// WithDoAs returns a context that asks the name node to check the permissions
+// of outgoing calls as user. Only service accounts configured as proxy users
+// may do so.
+func WithDoAs(ctx context.Context, user string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, DoAsHeader, user)
+}